@@ -0,0 +1,113 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+var deviceAliases = struct {
+	sync.RWMutex
+	devices map[string]string
+	names   map[string]string
+}{
+	devices: make(map[string]string),
+	names:   make(map[string]string),
+}
+
+// Register a friendly name for a device, e.g. "top drive" for "/dev/sr1".
+//
+// The alias can be passed as device to discid.Read, discid.ReadFeatures and
+// all other functions reading discs, and discid.DeviceDisplayName returns
+// the alias for the device. Registering an existing alias again replaces it.
+func RegisterDeviceAlias(alias string, device string) {
+	deviceAliases.Lock()
+	defer deviceAliases.Unlock()
+	// Another alias might have been registered for the previous device
+	// since, keep its display name then.
+	if previous, ok := deviceAliases.devices[alias]; ok && deviceAliases.names[previous] == alias {
+		delete(deviceAliases.names, previous)
+	}
+	deviceAliases.devices[alias] = device
+	deviceAliases.names[device] = alias
+}
+
+// Remove all registered device aliases.
+func ClearDeviceAliases() {
+	deviceAliases.Lock()
+	defer deviceAliases.Unlock()
+	deviceAliases.devices = make(map[string]string)
+	deviceAliases.names = make(map[string]string)
+}
+
+// Register the device aliases read from r.
+//
+// Each line has the format "alias = device", empty lines and lines starting
+// with "#" are ignored, e.g.:
+//
+//	# Drives of the digitization station
+//	top drive = /dev/sr1
+//	bottom drive = /dev/sr0
+func LoadDeviceAliases(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid device alias line %v: %q", lineNum, line)
+		}
+		alias := strings.TrimSpace(parts[0])
+		device := strings.TrimSpace(parts[1])
+		if alias == "" || device == "" {
+			return fmt.Errorf("invalid device alias line %v: %q", lineNum, line)
+		}
+		RegisterDeviceAlias(alias, device)
+	}
+	return scanner.Err()
+}
+
+// Return the device registered for the given alias. If name is not an
+// alias it is returned unchanged.
+func resolveDeviceAlias(name string) string {
+	deviceAliases.RLock()
+	defer deviceAliases.RUnlock()
+	if device, ok := deviceAliases.devices[name]; ok {
+		return device
+	}
+	return name
+}
+
+// Return the name for showing the device to users.
+//
+// If an alias has been registered for the device the alias is returned,
+// otherwise the device itself.
+func DeviceDisplayName(device string) string {
+	deviceAliases.RLock()
+	defer deviceAliases.RUnlock()
+	if alias, ok := deviceAliases.names[device]; ok {
+		return alias
+	}
+	return device
+}
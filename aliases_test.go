@@ -0,0 +1,68 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceAliases(t *testing.T) {
+	assert := assert.New(t)
+	defer ClearDeviceAliases()
+	RegisterDeviceAlias("top drive", "/dev/sr1")
+	assert.Equal("/dev/sr1", resolveDeviceAlias("top drive"))
+	assert.Equal("/dev/sr0", resolveDeviceAlias("/dev/sr0"))
+	assert.Equal("top drive", DeviceDisplayName("/dev/sr1"))
+	assert.Equal("/dev/sr0", DeviceDisplayName("/dev/sr0"))
+
+	RegisterDeviceAlias("top drive", "/dev/sr2")
+	assert.Equal("/dev/sr2", resolveDeviceAlias("top drive"))
+	assert.Equal("/dev/sr1", DeviceDisplayName("/dev/sr1"))
+	assert.Equal("top drive", DeviceDisplayName("/dev/sr2"))
+
+	ClearDeviceAliases()
+	assert.Equal("top drive", resolveDeviceAlias("top drive"))
+}
+
+func TestDeviceAliasesSameDevice(t *testing.T) {
+	assert := assert.New(t)
+	defer ClearDeviceAliases()
+	RegisterDeviceAlias("a", "/dev/sr0")
+	RegisterDeviceAlias("b", "/dev/sr0")
+	RegisterDeviceAlias("a", "/dev/sr1")
+	assert.Equal("/dev/sr0", resolveDeviceAlias("b"))
+	assert.Equal("b", DeviceDisplayName("/dev/sr0"))
+	assert.Equal("a", DeviceDisplayName("/dev/sr1"))
+}
+
+func TestLoadDeviceAliases(t *testing.T) {
+	defer ClearDeviceAliases()
+	config := "# Drives\n\ntop drive = /dev/sr1\nbottom drive=/dev/sr0\n"
+	assert.NoError(t, LoadDeviceAliases(strings.NewReader(config)))
+	assert.Equal(t, "/dev/sr1", resolveDeviceAlias("top drive"))
+	assert.Equal(t, "bottom drive", DeviceDisplayName("/dev/sr0"))
+}
+
+func TestLoadDeviceAliasesInvalid(t *testing.T) {
+	defer ClearDeviceAliases()
+	err := LoadDeviceAliases(strings.NewReader("top drive = /dev/sr1\n/dev/sr0\n"))
+	assert.EqualError(t, err, `invalid device alias line 2: "/dev/sr0"`)
+	err = LoadDeviceAliases(strings.NewReader(" = /dev/sr0\n"))
+	assert.EqualError(t, err, `invalid device alias line 1: "= /dev/sr0"`)
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// The result of an asynchronous read with discid.ReadAsync
+type ReadResult struct {
+	// The read disc, only valid if Err is nil. Use Disc.Close to free it.
+	Disc Disc
+	Err  error
+}
+
+// Read the disc in the given device in the background.
+//
+// This works like discid.ReadFeatures, but returns immediately. The result
+// is sent on the returned channel once the read finished, afterwards the
+// channel gets closed. The channel is buffered, so the read does not block
+// if the result is never received. In this case the disc is not closed.
+func ReadAsync(device string, features Feature) <-chan ReadResult {
+	return readAsync(func() (Disc, error) {
+		return ReadFeatures(device, features)
+	})
+}
+
+func readAsync(read func() (Disc, error)) <-chan ReadResult {
+	results := make(chan ReadResult, 1)
+	go func() {
+		defer close(results)
+		disc, err := read()
+		results <- ReadResult{disc, err}
+	}()
+	return results
+}
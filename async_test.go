@@ -0,0 +1,42 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAsync(t *testing.T) {
+	results := readAsync(func() (Disc, error) {
+		return Parse("1 1 10000 150")
+	})
+	result := <-results
+	if assert.NoError(t, result.Err) {
+		defer result.Disc.Close()
+		assert.Equal(t, 10000, result.Disc.Sectors())
+	}
+	_, ok := <-results
+	assert.False(t, ok)
+}
+
+func TestReadAsyncError(t *testing.T) {
+	failure := errors.New("no disc")
+	result := <-readAsync(func() (Disc, error) { return Disc{}, failure })
+	assert.Equal(t, failure, result.Err)
+}
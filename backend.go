@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// A source for reading the TOC and metadata of discs.
+//
+// Backends get registered by name with discid.Register, similar to the
+// drivers of database/sql. The backend "libdiscid" reading the local drives
+// with libdiscid is always registered.
+type Backend interface {
+	DiscReader
+	// Report whether the backend can be used in the current environment,
+	// e.g. whether the required library is installed.
+	Available() bool
+}
+
+// The name of the backend reading the local drives with libdiscid.
+const LibdiscidBackend = "libdiscid"
+
+var (
+	backendsMu    sync.RWMutex
+	backends      = make(map[string]Backend)
+	backendsOrder []string
+)
+
+func init() {
+	Register(LibdiscidBackend, libdiscidBackend{})
+}
+
+// Make a backend available by the provided name.
+//
+// If Register is called twice with the same name or if backend is nil, it
+// panics. When selecting a backend automatically the backends are tried in
+// the order they were registered.
+func Register(name string, backend Backend) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if backend == nil {
+		panic("discid: Register backend is nil")
+	}
+	if _, dup := backends[name]; dup {
+		panic("discid: Register called twice for backend " + name)
+	}
+	backends[name] = backend
+	backendsOrder = append(backendsOrder, name)
+}
+
+// Return the names of the registered backends in registration order.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	return append([]string{}, backendsOrder...)
+}
+
+// Return the backend registered with the given name.
+//
+// If name is an empty string the first available backend is returned. An
+// error is returned if the backend is unknown, not available or if no
+// backend is available at all.
+func SelectBackend(name string) (Backend, error) {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	if name == "" {
+		for _, n := range backendsOrder {
+			if backends[n].Available() {
+				return backends[n], nil
+			}
+		}
+		return nil, fmt.Errorf("no backend available: %w", ErrNotSupported)
+	}
+	backend, ok := backends[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown backend %q", name)
+	}
+	if !backend.Available() {
+		return nil, fmt.Errorf("backend %q is not available: %w", name, ErrNotSupported)
+	}
+	return backend, nil
+}
+
+// Read the disc in the given device using the backend with the given name.
+//
+// If name is an empty string the first available backend is used, see
+// discid.SelectBackend.
+func ReadWithBackend(ctx context.Context, name string, device string, features Feature) (Snapshot, error) {
+	backend, err := SelectBackend(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return backend.ReadDisc(ctx, device, features)
+}
+
+// Backend for the local drives using libdiscid.
+type libdiscidBackend struct {
+	LocalReader
+}
+
+// Report whether libdiscid can read discs in this build.
+func (libdiscidBackend) Available() bool {
+	return HasFeature(FeatureRead)
+}
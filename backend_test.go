@@ -0,0 +1,91 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type virtualBackend struct {
+	available bool
+	toc       string
+}
+
+func (b virtualBackend) ReadDisc(ctx context.Context, device string, features Feature) (Snapshot, error) {
+	disc, err := Parse(b.toc)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer disc.Close()
+	return disc.Snapshot(), nil
+}
+
+func (b virtualBackend) ListDevices(ctx context.Context) ([]string, error) {
+	return []string{"virtual"}, nil
+}
+
+func (b virtualBackend) Available() bool {
+	return b.available
+}
+
+// Remove the backends registered by a test from the registry again.
+func unregisterBackends(names ...string) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	for _, name := range names {
+		delete(backends, name)
+		for i, n := range backendsOrder {
+			if n == name {
+				backendsOrder = append(backendsOrder[:i], backendsOrder[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func TestRegisterBackend(t *testing.T) {
+	assert := assert.New(t)
+	defer unregisterBackends("test-virtual", "test-unavailable")
+	Register("test-virtual", virtualBackend{available: true, toc: "1 1 44942 150"})
+	Register("test-unavailable", virtualBackend{})
+	backends := Backends()
+	assert.Equal(LibdiscidBackend, backends[0])
+	assert.Contains(backends, "test-virtual")
+
+	snapshot, err := ReadWithBackend(context.Background(), "test-virtual", "", FeatureRead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("1 1 44942 150", snapshot.TocString)
+
+	_, err = SelectBackend("test-unavailable")
+	assert.True(errors.Is(err, ErrNotSupported))
+	_, err = SelectBackend("unknown")
+	assert.EqualError(err, `unknown backend "unknown"`)
+
+	backend, err := SelectBackend("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(backend.Available())
+
+	assert.Panics(func() { Register("test-virtual", virtualBackend{}) })
+	assert.Panics(func() { Register("test-nil", nil) })
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"net/url"
+	"os/exec"
+	"runtime"
+)
+
+// Base URL of the MusicBrainz disc ID pages
+const lookupBaseUrl = "https://musicbrainz.org/cdtoc/"
+
+// An URL for looking up the disc ID on MusicBrainz.
+//
+// The page lists all releases the disc ID is attached to.
+func (d Disc) LookupUrl() string {
+	return lookupBaseUrl + url.PathEscape(d.Id())
+}
+
+// Open the MusicBrainz lookup page for the disc in the system's web browser.
+//
+// The browser is launched with xdg-open on Linux and BSD, open on macOS and
+// the URL protocol handler on Windows. The function returns once the browser
+// has been launched.
+func OpenLookupInBrowser(d Disc) error {
+	return openBrowser(d.LookupUrl())
+}
+
+// Open the MusicBrainz submission page for the disc in the system's web
+// browser, see discid.OpenLookupInBrowser.
+//
+// On the page the user can attach the disc ID to an existing release or
+// add a new release.
+func OpenSubmissionInBrowser(d Disc) error {
+	return openBrowser(d.SubmissionUrl())
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	case "darwin", "ios":
+		cmd = exec.Command("open", url)
+	case "js", "wasip1", "android":
+		return ErrNotSupported
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go cmd.Wait()
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestLookupUrl(t *testing.T) {
+	disc, err := discid.Parse("1 1 44942 150")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "https://musicbrainz.org/cdtoc/ANJa4DGYN_ktpzOwvVPtcjwP7mE-", disc.LookupUrl())
+}
+
+func ExampleOpenLookupInBrowser() {
+	disc, err := discid.Read("") // Read from default device
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer disc.Close()
+	if err := discid.OpenLookupInBrowser(disc); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "sync"
+
+// Caches the result of a function returning static data, e.g. a cgo call.
+type cachedString struct {
+	mutex sync.Mutex
+	valid bool
+	value string
+	load  func() string
+}
+
+// Return the cached value, loading it on first use.
+func (c *cachedString) get() string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.valid {
+		c.value = c.load()
+		c.valid = true
+	}
+	return c.value
+}
+
+// Load the value again and cache it.
+func (c *cachedString) refresh() string {
+	value := c.load()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.value = value
+	c.valid = true
+	return value
+}
+
+var (
+	versionCache       = &cachedString{load: func() string { return version() }}
+	defaultDeviceCache = &cachedString{load: func() string { return defaultDevice() }}
+)
@@ -0,0 +1,36 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedString(t *testing.T) {
+	loads := 0
+	c := &cachedString{load: func() string {
+		loads++
+		return strconv.Itoa(loads)
+	}}
+	assert.Equal(t, "1", c.get())
+	assert.Equal(t, "1", c.get())
+	assert.Equal(t, "2", c.refresh())
+	assert.Equal(t, "2", c.get())
+	assert.Equal(t, 2, loads)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// A disc ID a disc might be known by, see Disc.CandidateIds.
+type CandidateId struct {
+	// The MusicBrainz disc ID
+	Id string `json:"id"`
+	// The FreeDB disc ID
+	FreedbId string `json:"freedb_id"`
+	// The TOC string the IDs were calculated from
+	TocString string `json:"toc"`
+	// True if only the audio session was used, as required by MusicBrainz.
+	// False for the ID of the full TOC including the data session.
+	AudioSession bool `json:"audio_session"`
+}
+
+// Return the disc IDs to try when looking up the disc.
+//
+// For a multi-session disc (Enhanced CD) with a data track following the
+// audio tracks, MusicBrainz calculates the disc ID only from the audio
+// session, see Disc.TrimLastTrack. Other tools calculate the ID from the
+// full TOC instead. For such a disc the ID of the audio session is returned
+// first, followed by the ID of the full TOC. This allows trying both IDs
+// and explaining to users why the ID differs from the one shown elsewhere.
+//
+// For all other discs, including discs read with libdiscid, which already
+// excludes the data session, only the ID of the disc is returned.
+func (d Disc) CandidateIds() ([]CandidateId, error) {
+	full := CandidateId{
+		Id:           d.Id(),
+		FreedbId:     d.FreedbId(),
+		TocString:    d.TocString(),
+		AudioSession: true,
+	}
+	last := d.LastTrackNum()
+	if last == d.FirstTrackNum() || !d.Track(last).Data || d.Track(last-1).Data {
+		return []CandidateId{full}, nil
+	}
+	audio, err := d.TrimLastTrack()
+	if err != nil {
+		return nil, err
+	}
+	defer audio.Close()
+	full.AudioSession = false
+	return []CandidateId{{
+		Id:           audio.Id(),
+		FreedbId:     audio.FreedbId(),
+		TocString:    audio.TocString(),
+		AudioSession: true,
+	}, full}, nil
+}
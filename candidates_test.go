@@ -0,0 +1,72 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestCandidateIdsEnhancedCd(t *testing.T) {
+	assert := assert.New(t)
+	cue := `FILE "disc.bin" BINARY
+  TRACK 01 AUDIO
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    INDEX 01 10:00:00
+  TRACK 03 MODE1/2352
+    INDEX 01 22:32:00
+`
+	disc, err := discid.ParseCueSheet(strings.NewReader(cue), 200000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	candidates, err := disc.CandidateIds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(candidates, 2) {
+		assert.True(candidates[0].AudioSession)
+		assert.Equal("1 2 90150 150 45150", candidates[0].TocString)
+		assert.False(candidates[1].AudioSession)
+		assert.Equal(disc.TocString(), candidates[1].TocString)
+		assert.Equal(disc.Id(), candidates[1].Id)
+		assert.NotEqual(candidates[0].Id, candidates[1].Id)
+		assert.NotEqual(candidates[0].FreedbId, candidates[1].FreedbId)
+	}
+}
+
+func TestCandidateIdsAudioCd(t *testing.T) {
+	disc, err := discid.Parse("1 2 200000 150 100000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	candidates, err := disc.CandidateIds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []discid.CandidateId{{
+		Id:           disc.Id(),
+		FreedbId:     disc.FreedbId(),
+		TocString:    "1 2 200000 150 100000",
+		AudioSession: true,
+	}}, candidates)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var (
+	cdinfoTrackRegexp = regexp.MustCompile(`^\s*(\d+): \d+:\d+:\d+\s+(\d+) (\w+)`)
+	cdinfoMcnRegexp   = regexp.MustCompile(`^Media Catalog Number \(MCN\): (\d{13})`)
+)
+
+// The track number cd-info uses for the leadout
+const cdinfoLeadoutTrack = 170
+
+// Parse the output of libcdio's cd-info utility and return a Disc instance
+// for the TOC contained in it.
+//
+// The TOC is read from the "CD-ROM Track List" table, the MCN is read as
+// well if present. Tracks not having the type "audio" are marked as data
+// tracks. If the last track is a data track following audio tracks, as on
+// multi-session discs (Enhanced CDs), it is removed in the same way
+// libdiscid does when reading such a disc, see Disc.TrimLastTrack.
+func ParseCdInfo(r io.Reader) (disc Disc, err error) {
+	first := 0
+	lbas := []int{}
+	leadout := -1
+	data := make(map[int]bool)
+	mcn := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := cdinfoMcnRegexp.FindStringSubmatch(line); match != nil {
+			mcn = match[1]
+			continue
+		}
+		match := cdinfoTrackRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		track, e1 := strconv.Atoi(match[1])
+		lsn, e2 := strconv.Atoi(match[2])
+		if e1 != nil || e2 != nil {
+			return disc, fmt.Errorf("invalid track line %q", line)
+		}
+		if track == cdinfoLeadoutTrack {
+			leadout = lsn
+			continue
+		}
+		if first == 0 {
+			first = track
+		} else if track != first+len(lbas) {
+			return disc, fmt.Errorf("track numbers are not consecutive at track %v", track)
+		}
+		lbas = append(lbas, lsn)
+		if match[3] != "audio" {
+			data[track] = true
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if len(lbas) > 0 && leadout < 0 {
+		return disc, errors.New("no leadout found")
+	}
+
+	disc, err = putLbas(first, lbas, leadout)
+	if err != nil {
+		return
+	}
+	disc = Disc{overlayHandle{handle: disc.handle, mcnStr: mcn, data: data}}
+	last := disc.LastTrackNum()
+	if last > first && data[last] && !data[last-1] {
+		trimmed, e := disc.TrimLastTrack()
+		disc.Close()
+		return trimmed, e
+	}
+	return
+}
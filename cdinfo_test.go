@@ -0,0 +1,68 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestParseCdInfo(t *testing.T) {
+	f, err := os.Open("testdata/cd-info.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	disc, err := discid.ParseCdInfo(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	// The data session is removed
+	assert.Equal(t, "lSOVc5h6IXSuzcamJS1Gp4_tRuA-", disc.Id())
+	assert.Equal(t,
+		"1 11 242457 150 44942 61305 72755 96360 130485 147315 164275 190702 205412 220437",
+		disc.TocString())
+	assert.Equal(t, "4006381333931", disc.Mcn())
+	assert.False(t, disc.Track(11).Data)
+}
+
+func TestParseCdInfoDataTrackFirst(t *testing.T) {
+	output := `CD-ROM Track List (1 - 2)
+  #: MSF       LSN    Type   Green? Copy? Channels Premphasis?
+  1: 00:02:00  000000 data   false  no
+  2: 10:02:00  045000 audio  false  no    2        no
+170: 20:02:00  090000 leadout (201 MB raw, 175 MB formatted)
+`
+	disc, err := discid.ParseCdInfo(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "1 2 90150 150 45150", disc.TocString())
+	assert.True(t, disc.Track(1).Data)
+	assert.False(t, disc.Track(2).Data)
+}
+
+func TestParseCdInfoNoLeadout(t *testing.T) {
+	output := "  1: 00:02:00  000000 audio  false  no    2        no\n"
+	_, err := discid.ParseCdInfo(strings.NewReader(output))
+	assert.EqualError(t, err, "no leadout found")
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"io"
+	"regexp"
+)
+
+var cdparanoiaTrackRegexp = regexp.MustCompile(
+	`^\s*(?P<track>\d+)\.\s+(?P<length>\d+) \[[^\]]*\]\s+(?P<start>\d+) \[`)
+
+// Parse the table of contents printed by "cdparanoia -Q" and return a Disc
+// instance for it.
+//
+// cdparanoia prints the output of -Q to stderr, so it has to be redirected
+// when piping it into a program, e.g. "cdparanoia -Q 2>&1 | myprogram".
+// Only audio tracks are listed by cdparanoia, data tracks are ignored.
+func ParseCdparanoiaToc(r io.Reader) (Disc, error) {
+	return parseTrackLines(r, cdparanoiaTrackRegexp)
+}
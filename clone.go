@@ -0,0 +1,30 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Return an independent copy of the disc.
+//
+// The copy has its own handle with the same TOC, MCN and per track data. It
+// does not share any resources with d, hence it stays valid if d gets closed
+// and can safely be used by another goroutine. The copy must be closed
+// separately.
+func (d Disc) Clone() (Disc, error) {
+	clone, err := d.Toc().Disc()
+	if err != nil {
+		return clone, err
+	}
+	return withMetadata(clone.handle, d, 0), nil
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestClone(t *testing.T) {
+	cue := `CATALOG 4006381333931
+FILE "disc.wav" WAVE
+  TRACK 01 AUDIO
+    ISRC DEA123400001
+    INDEX 01 00:00:00
+    INDEX 02 00:10:00
+  TRACK 02 AUDIO
+    INDEX 01 10:00:00
+`
+	disc, err := discid.ParseCueSheet(strings.NewReader(cue), 90000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := disc.Snapshot()
+	clone, err := disc.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clone.Close()
+	disc.Close()
+	assert.Equal(t, expected, clone.Snapshot())
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Print the disc information in the format of cd-discid, so discid can be
+// used as a replacement for cd-discid in existing ripping scripts.
+func runCddb(args []string) {
+	flags := flag.NewFlagSet("cddb", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s cddb [device]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() > 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	disc, err := discid.Read(discid.NormalizeDevice(flags.Arg(0)))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	defer disc.Close()
+	fmt.Println(disc.CdDiscidString())
+}
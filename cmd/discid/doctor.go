@@ -0,0 +1,62 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Check the environment for reading discs and print a report.
+//
+// Exits with status 1 if any check failed.
+func runDoctor(args []string) {
+	flags := flag.NewFlagSet("doctor", flag.ExitOnError)
+	format := flags.String("format", "text", "output format (text or json)")
+	probe := flags.Bool("probe", true, "read inserted discs to check MCN and ISRC support")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s doctor [flags]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() > 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	report := discid.Diagnose(*probe)
+	var err error
+	switch *format {
+	case "text":
+		err = report.WriteText(os.Stdout)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+	default:
+		fatalf("unsupported format %q", *format)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if !report.Ok() {
+		os.Exit(1)
+	}
+}
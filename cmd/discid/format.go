@@ -0,0 +1,119 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Writes a disc in a specific output format.
+type formatFunc func(w io.Writer, s discid.Snapshot) error
+
+var formats = map[string]formatFunc{
+	"text": writeText,
+	"json": writeJson,
+	"yaml": writeYaml,
+	"tsv":  writeTsv,
+}
+
+func writeText(w io.Writer, s discid.Snapshot) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Disc ID    : %v\n", s.Id)
+	fmt.Fprintf(&b, "FreeDB ID  : %v\n", s.FreedbId)
+	fmt.Fprintf(&b, "TOC        : %v\n", s.TocString)
+	fmt.Fprintf(&b, "MCN        : %v\n", s.Mcn)
+	fmt.Fprintf(&b, "First track: %v\n", s.FirstTrackNum)
+	fmt.Fprintf(&b, "Last track : %v\n", s.LastTrackNum)
+	fmt.Fprintf(&b, "Sectors    : %v (%v)\n", s.Sectors, discid.FormatMSF(s.Sectors))
+	for _, track := range s.Tracks {
+		fmt.Fprintf(&b, "\nTrack #%v:\n", track.Number)
+		fmt.Fprintf(&b, "    ISRC   : %v\n", track.Isrc)
+		fmt.Fprintf(&b, "    Offset : %v (%v)\n", track.Offset, track.OffsetMSF())
+		fmt.Fprintf(&b, "    Sectors: %v (%v)\n", track.Sectors, track.LengthMSF())
+		if len(track.Indexes) > 0 {
+			fmt.Fprintf(&b, "    Indexes: %v\n", joinInts(track.Indexes, ", "))
+		}
+		if track.Data {
+			fmt.Fprintf(&b, "    Data   : yes\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeJson(w io.Writer, s discid.Snapshot) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Write YAML using the same keys as the JSON output. Strings are always
+// double quoted, which makes them valid YAML regardless of their content.
+// Like in the JSON output indexes and data are omitted if empty.
+func writeYaml(w io.Writer, s discid.Snapshot) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "id: %v\n", strconv.Quote(s.Id))
+	fmt.Fprintf(&b, "freedb_id: %v\n", strconv.Quote(s.FreedbId))
+	fmt.Fprintf(&b, "toc: %v\n", strconv.Quote(s.TocString))
+	fmt.Fprintf(&b, "first_track: %v\n", s.FirstTrackNum)
+	fmt.Fprintf(&b, "last_track: %v\n", s.LastTrackNum)
+	fmt.Fprintf(&b, "sectors: %v\n", s.Sectors)
+	fmt.Fprintf(&b, "mcn: %v\n", strconv.Quote(s.Mcn))
+	fmt.Fprintf(&b, "tracks:\n")
+	for _, track := range s.Tracks {
+		fmt.Fprintf(&b, "  - number: %v\n", track.Number)
+		fmt.Fprintf(&b, "    offset: %v\n", track.Offset)
+		fmt.Fprintf(&b, "    sectors: %v\n", track.Sectors)
+		fmt.Fprintf(&b, "    isrc: %v\n", strconv.Quote(track.Isrc))
+		if len(track.Indexes) > 0 {
+			fmt.Fprintf(&b, "    indexes: [%v]\n", joinInts(track.Indexes, ", "))
+		}
+		if track.Data {
+			fmt.Fprintf(&b, "    data: true\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Write one line per track, the disc fields are repeated on each line. The
+// indexes of a track are separated by commas.
+func writeTsv(w io.Writer, s discid.Snapshot) error {
+	var b strings.Builder
+	fmt.Fprintln(&b, "id\tfreedb_id\ttoc\tfirst_track\tlast_track\tdisc_sectors\tmcn\ttrack\toffset\tsectors\tisrc\tindexes\tdata")
+	for _, track := range s.Tracks {
+		fmt.Fprintf(&b, "%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n",
+			s.Id, s.FreedbId, s.TocString, s.FirstTrackNum, s.LastTrackNum, s.Sectors, s.Mcn,
+			track.Number, track.Offset, track.Sectors, track.Isrc, joinInts(track.Indexes, ","), track.Data)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Join the decimal representations of the values with sep.
+func joinInts(values []int, sep string) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = strconv.Itoa(value)
+	}
+	return strings.Join(strs, sep)
+}
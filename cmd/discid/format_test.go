@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSnapshot = discid.Snapshot{
+	Id:            "ANJa4DGYN_ktpzOwvVPtcjwP7mE-",
+	FreedbId:      "02025701",
+	TocString:     "1 1 44942 150",
+	FirstTrackNum: 1,
+	LastTrackNum:  1,
+	Sectors:       44942,
+	Mcn:           "0123456789012",
+	Tracks: []discid.Track{
+		{Number: 1, Offset: 150, Sectors: 44792, Isrc: "DEAAA0000001"},
+	},
+}
+
+var testSnapshotIndexes = discid.Snapshot{
+	Id:            "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-",
+	FreedbId:      "0a009302",
+	TocString:     "1 2 44942 150 20000",
+	FirstTrackNum: 1,
+	LastTrackNum:  2,
+	Sectors:       44942,
+	Tracks: []discid.Track{
+		{Number: 1, Offset: 150, Sectors: 19850, Indexes: []int{10000, 15000}},
+		{Number: 2, Offset: 20000, Sectors: 24942, Data: true},
+	},
+}
+
+func TestWriteText(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, writeText(&b, testSnapshot))
+	assert.Contains(t, b.String(), "Sectors    : 44942 (09:59:17)\n")
+	assert.Contains(t, b.String(), "    Offset : 150 (00:02:00)\n")
+	assert.Contains(t, b.String(), "    Sectors: 44792 (09:57:17)\n")
+}
+
+func TestWriteJson(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, writeJson(&b, testSnapshot))
+	var s discid.Snapshot
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &s))
+	assert.Equal(t, testSnapshot, s)
+}
+
+func TestWriteYaml(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, writeYaml(&b, testSnapshot))
+	assert.Equal(t, `id: "ANJa4DGYN_ktpzOwvVPtcjwP7mE-"
+freedb_id: "02025701"
+toc: "1 1 44942 150"
+first_track: 1
+last_track: 1
+sectors: 44942
+mcn: "0123456789012"
+tracks:
+  - number: 1
+    offset: 150
+    sectors: 44792
+    isrc: "DEAAA0000001"
+`, b.String())
+}
+
+func TestWriteTsv(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, writeTsv(&b, testSnapshot))
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t,
+		"ANJa4DGYN_ktpzOwvVPtcjwP7mE-\t02025701\t1 1 44942 150\t1\t1\t44942\t0123456789012\t1\t150\t44792\tDEAAA0000001\t\tfalse",
+		lines[1])
+}
+
+func TestWriteIndexesAndData(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, writeYaml(&b, testSnapshotIndexes))
+	assert.Contains(t, b.String(), "    isrc: \"\"\n    indexes: [10000, 15000]\n  - number: 2\n")
+	assert.Contains(t, b.String(), "    data: true\n")
+	b.Reset()
+	assert.NoError(t, writeTsv(&b, testSnapshotIndexes))
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.True(t, strings.HasSuffix(lines[1], "\t10000,15000\tfalse"), lines[1])
+	assert.True(t, strings.HasSuffix(lines[2], "\t\ttrue"), lines[2])
+	b.Reset()
+	assert.NoError(t, writeText(&b, testSnapshotIndexes))
+	assert.Contains(t, b.String(), "    Indexes: 10000, 15000\n")
+	assert.Contains(t, b.String(), "    Data   : yes\n")
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriteErrors(t *testing.T) {
+	for name, format := range formats {
+		assert.Error(t, format(failingWriter{}, testSnapshot), name)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+)
+
+// Look up the disc on MusicBrainz and print the matching releases.
+//
+// The argument is either a device or a TOC string as printed by discid,
+// given as a single argument or as separate numbers.
+func runLookup(args []string) {
+	flags := flag.NewFlagSet("lookup", flag.ExitOnError)
+	format := flags.String("format", "table", "output format (table or json)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s lookup [flags] [device|toc]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	var write func(io.Writer, []lookup.Release) error
+	switch *format {
+	case "table":
+		write = writeReleaseTable
+	case "json":
+		write = writeReleasesJson
+	default:
+		fatalf("unsupported format %q", *format)
+	}
+
+	var disc discid.Disc
+	var err error
+	if toc, ok := tocArgument(flags.Args()); ok {
+		disc, err = discid.Parse(toc)
+	} else if flags.NArg() > 1 {
+		flags.Usage()
+		os.Exit(2)
+	} else {
+		disc, err = discid.ReadFeatures(discid.NormalizeDevice(flags.Arg(0)), discid.FeatureMcn)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+	snapshot := disc.Snapshot()
+	disc.Close()
+
+	mb := lookup.MusicBrainz{UserAgent: lookup.DefaultUserAgent}
+	releases, err := mb.LookupWithBarcodeFallback(context.Background(), snapshot)
+	if err == lookup.ErrNotFound {
+		fatalf("no release found for disc ID %v, submit it at %v",
+			snapshot.Id, snapshot.SubmissionUrl())
+	} else if err != nil {
+		fatalf("%v", err)
+	}
+	ranked := lookup.RankReleases(releases, lookup.RankPreferences{Mcn: snapshot.Mcn})
+	releases = releases[:0]
+	for _, r := range ranked {
+		releases = append(releases, r.Release)
+	}
+	if err := write(os.Stdout, releases); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// Return the TOC string if args are a TOC, either as a single argument
+// separated by spaces or "+" or as separate arguments.
+func tocArgument(args []string) (string, bool) {
+	toc := strings.Replace(strings.Join(args, " "), "+", " ", -1)
+	parts := strings.Fields(toc)
+	if len(parts) < 3 {
+		return "", false
+	}
+	for _, part := range parts {
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return "", false
+			}
+		}
+	}
+	return strings.Join(parts, " "), true
+}
+
+func writeReleaseTable(w io.Writer, releases []lookup.Release) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ARTIST\tTITLE\tCOUNTRY\tDATE\tBARCODE\tID")
+	for _, r := range releases {
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\n",
+			r.Artist(), r.Title, r.Country, r.Date, r.Barcode, r.Id)
+	}
+	return tw.Flush()
+}
+
+func writeReleasesJson(w io.Writer, releases []lookup.Release) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(releases)
+}
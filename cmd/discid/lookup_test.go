@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTocArgument(t *testing.T) {
+	toc, ok := tocArgument([]string{"1 2 34567 150 10000"})
+	assert.True(t, ok)
+	assert.Equal(t, "1 2 34567 150 10000", toc)
+	toc, ok = tocArgument([]string{"1+2+34567+150+10000"})
+	assert.True(t, ok)
+	assert.Equal(t, "1 2 34567 150 10000", toc)
+	toc, ok = tocArgument([]string{"1", "2", "34567", "150", "10000"})
+	assert.True(t, ok)
+	assert.Equal(t, "1 2 34567 150 10000", toc)
+	_, ok = tocArgument([]string{"/dev/sr0"})
+	assert.False(t, ok)
+	_, ok = tocArgument(nil)
+	assert.False(t, ok)
+}
+
+func TestWriteReleaseTable(t *testing.T) {
+	release := lookup.Release{
+		Id:      "abc",
+		Title:   "Test Album",
+		Country: "DE",
+		Date:    "1995",
+		Barcode: "4006381333931",
+	}
+	release.ArtistCredit = []lookup.ArtistCredit{{Name: "Foo"}}
+	var b bytes.Buffer
+	assert.NoError(t, writeReleaseTable(&b, []lookup.Release{release}))
+	assert.Equal(t, "ARTIST  TITLE       COUNTRY  DATE  BARCODE        ID\n"+
+		"Foo     Test Album  DE       1995  4006381333931  abc\n", b.String())
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// discid prints the disc IDs and other details of an audio CD.
+//
+// Usage:
+//
+//	discid [flags] [device]
+//	discid <command> [arguments]
+//
+// If no device is given the default device is used. The output format can be
+// selected with -format, supported formats are text, json, yaml and tsv.
+// Alternatively -template renders the disc with a Go text/template, e.g.
+// -template '{{.Id}}{{range .Tracks}} {{msf .Sectors}}{{end}}'.
+//
+// Device aliases, e.g. "top drive = /dev/sr1", are read from the file
+// discid/aliases in the user's configuration directory and can be used in
+// place of device names.
+//
+// The commands are:
+//
+//	cddb   print the disc information in the output format of cd-discid
+//	doctor check libdiscid, the drives and their permissions and print a report
+//	lookup look up the disc on MusicBrainz and print the matching releases
+//	submit print the URL for submitting the disc ID to MusicBrainz
+//	tui    interactive terminal UI showing all drives and the inserted discs
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Sub commands, each called with the remaining command line arguments
+var commands = map[string]func(args []string){
+	"cddb":   runCddb,
+	"doctor": runDoctor,
+	"lookup": runLookup,
+	"submit": runSubmit,
+	"tui":    runTui,
+}
+
+func main() {
+	loadDeviceAliases()
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			command(os.Args[2:])
+			return
+		}
+	}
+
+	format := flag.String("format", "text", "output format (text, json, yaml or tsv)")
+	mcn := flag.Bool("mcn", false, "read the media catalogue number (MCN)")
+	isrc := flag.Bool("isrc", false, "read the ISRCs of all tracks")
+	tmplText := flag.String("template", "", "render the disc with a Go text/template instead of -format")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [device]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	write, ok := formats[*format]
+	if !ok {
+		fatalf("unsupported format %q", *format)
+	}
+	if *tmplText != "" {
+		tmpl, err := discid.ParseTemplate(*tmplText)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		write = func(w io.Writer, s discid.Snapshot) error {
+			return s.Render(w, tmpl)
+		}
+	}
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	features := discid.Feature(discid.FeatureRead)
+	if *mcn {
+		features |= discid.FeatureMcn
+	}
+	if *isrc {
+		features |= discid.FeatureIsrc
+	}
+	disc, err := discid.ReadFeatures(discid.NormalizeDevice(flag.Arg(0)), features)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	snapshot := disc.Snapshot()
+	disc.Close()
+	if err := write(os.Stdout, snapshot); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// Load the device aliases from the user's configuration directory, if present.
+func loadDeviceAliases() {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return
+	}
+	f, err := os.Open(filepath.Join(dir, "discid", "aliases"))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	if err := discid.LoadDeviceAliases(f); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func fatalf(format string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, "discid: "+format+"\n", a...)
+	os.Exit(1)
+}
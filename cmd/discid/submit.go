@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Print the URL for submitting the disc ID to MusicBrainz and optionally
+// open it in the browser.
+//
+// The MusicBrainz web service offers no way to attach disc IDs, the
+// submission always requires the user to choose the release on the
+// website.
+func runSubmit(args []string) {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	open := flags.Bool("open", false, "open the submission URL in the web browser")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s submit [flags] [device]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() > 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	disc, err := discid.Read(discid.NormalizeDevice(flags.Arg(0)))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	defer disc.Close()
+	fmt.Println(disc.SubmissionUrl())
+	if *open {
+		if err := discid.OpenSubmissionInBrowser(disc); err != nil {
+			fatalf("%v", err)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	discid "github.com/phw/go-discid"
+)
+
+// State of a single drive shown in the terminal UI
+type driveState struct {
+	device string
+	// Vendor and model of the drive, might be empty
+	description string
+	status      string
+	disc        *discid.Snapshot
+}
+
+// Interactive terminal UI showing all drives and the disc in the selected drive.
+type tui struct {
+	mutex    sync.Mutex
+	out      io.Writer
+	interval time.Duration
+	drives   []*driveState
+	selected int
+}
+
+func runTui(args []string) {
+	flags := flag.NewFlagSet("tui", flag.ExitOnError)
+	interval := flags.Duration("interval", discid.DefaultWatchInterval, "interval for polling the drives")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s tui [flags]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	t := &tui{out: os.Stdout, interval: *interval}
+	t.setDevices(discid.ListDevices())
+	t.describeDevices(discid.DeviceDescription)
+	go t.poll()
+	t.handleInput(os.Stdin)
+}
+
+func (t *tui) setDevices(devices []string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.drives = make([]*driveState, len(devices))
+	for i, device := range devices {
+		t.drives[i] = &driveState{device: device, status: "unknown"}
+	}
+	if t.selected >= len(t.drives) {
+		t.selected = 0
+	}
+}
+
+// Set the descriptions of all drives.
+func (t *tui) describeDevices(describe func(device string) string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, drive := range t.drives {
+		drive.description = describe(drive.device)
+	}
+}
+
+// Poll all drives and redraw the screen whenever a status changed.
+func (t *tui) poll() {
+	for {
+		t.mutex.Lock()
+		drives := t.drives
+		t.mutex.Unlock()
+		changed := false
+		for _, drive := range drives {
+			if t.update(drive) {
+				changed = true
+			}
+		}
+		if changed {
+			t.redraw()
+		}
+		time.Sleep(t.interval)
+	}
+}
+
+// Read the disc in the drive, the full data is only read for new discs.
+func (t *tui) update(drive *driveState) bool {
+	disc, err := discid.Read(drive.device)
+	if err != nil {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		changed := drive.disc != nil || drive.status != "no disc"
+		drive.status = "no disc"
+		drive.disc = nil
+		return changed
+	}
+	toc := disc.TocString()
+	disc.Close()
+	t.mutex.Lock()
+	if drive.disc != nil && drive.disc.TocString == toc {
+		t.mutex.Unlock()
+		return false
+	}
+	drive.status = "reading"
+	t.mutex.Unlock()
+	t.redraw()
+
+	disc, err = discid.ReadFeatures(drive.device, discid.FeatureAll)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if err != nil {
+		drive.status = err.Error()
+		drive.disc = nil
+		return true
+	}
+	snapshot := disc.Snapshot()
+	disc.Close()
+	drive.status = "audio CD"
+	drive.disc = &snapshot
+	return true
+}
+
+// Process the commands entered by the user until "q" is entered.
+func (t *tui) handleInput(in io.Reader) {
+	t.redraw()
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		command := strings.TrimSpace(scanner.Text())
+		switch command {
+		case "q":
+			return
+		case "r":
+			t.setDevices(discid.ListDevices())
+			t.describeDevices(discid.DeviceDescription)
+			go func() {
+				t.mutex.Lock()
+				drives := t.drives
+				t.mutex.Unlock()
+				for _, drive := range drives {
+					t.update(drive)
+				}
+				t.redraw()
+			}()
+		default:
+			if n, err := strconv.Atoi(command); err == nil {
+				t.mutex.Lock()
+				if n >= 1 && n <= len(t.drives) {
+					t.selected = n - 1
+				}
+				t.mutex.Unlock()
+			}
+		}
+		t.redraw()
+	}
+}
+
+func (t *tui) redraw() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	// Move the cursor home and clear the screen
+	fmt.Fprint(t.out, "\033[H\033[2J")
+	t.render(t.out)
+}
+
+func (t *tui) render(out io.Writer) {
+	fmt.Fprintf(out, "%v\n\nDrives:\n", discid.Version())
+	if len(t.drives) == 0 {
+		fmt.Fprintln(out, "  no drives found")
+	}
+	for i, drive := range t.drives {
+		marker := " "
+		if i == t.selected {
+			marker = ">"
+		}
+		name := discid.DeviceDisplayName(drive.device)
+		if drive.description != "" {
+			name += " (" + drive.description + ")"
+		}
+		fmt.Fprintf(out, "%v %d) %v: %v\n", marker, i+1, name, drive.status)
+	}
+	fmt.Fprintln(out)
+
+	if t.selected < len(t.drives) && t.drives[t.selected].disc != nil {
+		s := t.drives[t.selected].disc
+		fmt.Fprintf(out, "Disc ID  : %v\n", s.Id)
+		fmt.Fprintf(out, "FreeDB ID: %v\n", s.FreedbId)
+		fmt.Fprintf(out, "MCN      : %v\n\n", s.Mcn)
+		s.FormatTable(out)
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintln(out, "Enter a drive number to select it, r to rescan drives, q to quit.")
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuiRender(t *testing.T) {
+	ui := &tui{}
+	ui.setDevices([]string{"/dev/sr0", "/dev/sr1"})
+	ui.describeDevices(func(device string) string {
+		if device == "/dev/sr1" {
+			return "HL-DT-ST DVDRAM GP57EB40"
+		}
+		return ""
+	})
+	ui.drives[0].status = "audio CD"
+	ui.drives[0].disc = &testSnapshot
+	ui.drives[1].status = "no disc"
+
+	var b bytes.Buffer
+	ui.render(&b)
+	out := b.String()
+	assert.Contains(t, out, "> 1) /dev/sr0: audio CD\n")
+	assert.Contains(t, out, "  2) /dev/sr1 (HL-DT-ST DVDRAM GP57EB40): no disc\n")
+	assert.Contains(t, out, "Disc ID  : "+testSnapshot.Id)
+	assert.Contains(t, out, "DEAAA0000001")
+
+	ui.selected = 1
+	b.Reset()
+	ui.render(&b)
+	assert.NotContains(t, b.String(), "Disc ID")
+}
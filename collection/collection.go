@@ -0,0 +1,290 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// collection stores scanned discs in a local SQLite database.
+//
+// Each scan records the full disc data (TOC, disc IDs, MCN and ISRCs)
+// together with the scan time and the drive used. The query helpers allow
+// finding scans by disc ID, FreeDB ID, MCN or ISRC, e.g. for building a
+// personal CD catalog:
+//
+//	c, err := collection.Open("discs.sqlite")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer c.Close()
+//	disc, err := discid.ReadFeatures("", discid.FeatureAll)
+//	...
+//	c.Add(collection.Scan{Disc: disc.Snapshot(), Drive: "/dev/cdrom"})
+//
+// This package requires cgo, as it uses github.com/mattn/go-sqlite3.
+package collection
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	discid "github.com/phw/go-discid"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS scans (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	disc_id TEXT NOT NULL,
+	freedb_id TEXT NOT NULL,
+	toc TEXT NOT NULL,
+	first_track INTEGER NOT NULL,
+	last_track INTEGER NOT NULL,
+	sectors INTEGER NOT NULL,
+	mcn TEXT NOT NULL DEFAULT '',
+	drive TEXT NOT NULL DEFAULT '',
+	scanned_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS scans_disc_id ON scans (disc_id);
+CREATE INDEX IF NOT EXISTS scans_freedb_id ON scans (freedb_id);
+CREATE INDEX IF NOT EXISTS scans_mcn ON scans (mcn);
+CREATE TABLE IF NOT EXISTS tracks (
+	scan_id INTEGER NOT NULL REFERENCES scans (id) ON DELETE CASCADE,
+	number INTEGER NOT NULL,
+	offset INTEGER NOT NULL,
+	sectors INTEGER NOT NULL,
+	isrc TEXT NOT NULL DEFAULT '',
+	indexes TEXT NOT NULL DEFAULT '',
+	data INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (scan_id, number)
+);
+CREATE INDEX IF NOT EXISTS tracks_isrc ON tracks (isrc);
+`
+
+// A disc scan stored in the collection
+type Scan struct {
+	// Unique ID of the scan in the collection, set by Collection.Add
+	Id int64
+	// The disc data
+	Disc discid.Snapshot
+	// The drive the disc was read from, might be empty
+	Drive string
+	// The time of the scan. Collection.Add uses the current time if zero.
+	ScannedAt time.Time
+}
+
+// A SQLite database of scanned discs
+type Collection struct {
+	db *sql.DB
+}
+
+// Open the collection database at path.
+//
+// The database file and the tables are created if they do not exist yet.
+func Open(path string) (*Collection, error) {
+	db, err := sql.Open("sqlite3", path+"?_foreign_keys=on")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Collection{db}, nil
+}
+
+// Close the database.
+func (c *Collection) Close() error {
+	return c.db.Close()
+}
+
+// Store a scan in the collection and return its ID.
+//
+// The Id of the given scan is ignored.
+func (c *Collection) Add(scan Scan) (int64, error) {
+	if scan.ScannedAt.IsZero() {
+		scan.ScannedAt = time.Now()
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	disc := scan.Disc
+	result, err := tx.Exec(`INSERT INTO scans
+		(disc_id, freedb_id, toc, first_track, last_track, sectors, mcn, drive, scanned_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		disc.Id, disc.FreedbId, disc.TocString, disc.FirstTrackNum, disc.LastTrackNum,
+		disc.Sectors, disc.Mcn, scan.Drive, formatTime(scan.ScannedAt))
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	for _, track := range disc.Tracks {
+		indexes, err := encodeIndexes(track.Indexes)
+		if err != nil {
+			return 0, err
+		}
+		_, err = tx.Exec(`INSERT INTO tracks
+			(scan_id, number, offset, sectors, isrc, indexes, data)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			id, track.Number, track.Offset, track.Sectors, track.Isrc, indexes, track.Data)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return id, tx.Commit()
+}
+
+// Remove the scan with the given ID. Returns sql.ErrNoRows if there is no
+// such scan.
+func (c *Collection) Delete(id int64) error {
+	result, err := c.db.Exec("DELETE FROM scans WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// Return the scan with the given ID. Returns sql.ErrNoRows if there is no
+// such scan.
+func (c *Collection) Get(id int64) (Scan, error) {
+	scans, err := c.query("WHERE id = ?", id)
+	if err != nil {
+		return Scan{}, err
+	}
+	if len(scans) == 0 {
+		return Scan{}, sql.ErrNoRows
+	}
+	return scans[0], nil
+}
+
+// Return all scans ordered by scan time.
+func (c *Collection) All() ([]Scan, error) {
+	return c.query("")
+}
+
+// Return the number of scans in the collection.
+func (c *Collection) Count() (int, error) {
+	var count int
+	err := c.db.QueryRow("SELECT COUNT(*) FROM scans").Scan(&count)
+	return count, err
+}
+
+// Return all scans of discs with the given MusicBrainz disc ID.
+func (c *Collection) FindByDiscId(id string) ([]Scan, error) {
+	return c.query("WHERE disc_id = ?", id)
+}
+
+// Return all scans of discs with the given FreeDB ID.
+func (c *Collection) FindByFreedbId(id string) ([]Scan, error) {
+	return c.query("WHERE freedb_id = ?", id)
+}
+
+// Return all scans of discs with the given MCN.
+func (c *Collection) FindByMcn(mcn string) ([]Scan, error) {
+	return c.query("WHERE mcn = ?", mcn)
+}
+
+// Return all scans of discs having a track with the given ISRC.
+func (c *Collection) FindByIsrc(isrc string) ([]Scan, error) {
+	return c.query("WHERE id IN (SELECT scan_id FROM tracks WHERE isrc = ?)", isrc)
+}
+
+// Return the scans made in the time range [from, to).
+func (c *Collection) FindByScanTime(from time.Time, to time.Time) ([]Scan, error) {
+	return c.query("WHERE scanned_at >= ? AND scanned_at < ?", formatTime(from), formatTime(to))
+}
+
+// Query scans with the given WHERE clause, including their tracks.
+func (c *Collection) query(where string, args ...interface{}) ([]Scan, error) {
+	rows, err := c.db.Query(`SELECT
+		id, disc_id, freedb_id, toc, first_track, last_track, sectors, mcn, drive, scanned_at
+		FROM scans `+where+` ORDER BY scanned_at, id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	scans := []Scan{}
+	for rows.Next() {
+		var scan Scan
+		var scannedAt string
+		disc := &scan.Disc
+		err := rows.Scan(&scan.Id, &disc.Id, &disc.FreedbId, &disc.TocString,
+			&disc.FirstTrackNum, &disc.LastTrackNum, &disc.Sectors, &disc.Mcn,
+			&scan.Drive, &scannedAt)
+		if err != nil {
+			return nil, err
+		}
+		if scan.ScannedAt, err = time.Parse(time.RFC3339Nano, scannedAt); err != nil {
+			return nil, err
+		}
+		scans = append(scans, scan)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range scans {
+		if scans[i].Disc.Tracks, err = c.tracks(scans[i].Id); err != nil {
+			return nil, err
+		}
+	}
+	return scans, nil
+}
+
+func (c *Collection) tracks(scanId int64) ([]discid.Track, error) {
+	rows, err := c.db.Query(`SELECT number, offset, sectors, isrc, indexes, data
+		FROM tracks WHERE scan_id = ? ORDER BY number`, scanId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tracks := []discid.Track{}
+	for rows.Next() {
+		var track discid.Track
+		var indexes string
+		err := rows.Scan(&track.Number, &track.Offset, &track.Sectors, &track.Isrc,
+			&indexes, &track.Data)
+		if err != nil {
+			return nil, err
+		}
+		if indexes != "" {
+			if err := json.Unmarshal([]byte(indexes), &track.Indexes); err != nil {
+				return nil, err
+			}
+		}
+		tracks = append(tracks, track)
+	}
+	return tracks, rows.Err()
+}
+
+// Times are stored as UTC in RFC 3339 format, which sorts chronologically
+// as long as the fractional seconds have a fixed width.
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000Z07:00")
+}
+
+func encodeIndexes(indexes []int) (string, error) {
+	if len(indexes) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(indexes)
+	return string(b), err
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collection_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDisc = discid.Snapshot{
+	Id:            "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-",
+	FreedbId:      "830abf0a",
+	TocString:     "1 3 206535 150 18901 39738",
+	FirstTrackNum: 1,
+	LastTrackNum:  3,
+	Sectors:       206535,
+	Mcn:           "4006381333931",
+	Tracks: []discid.Track{
+		{Number: 1, Offset: 150, Sectors: 18751, Isrc: "DEA123400001", Indexes: []int{5000}},
+		{Number: 2, Offset: 18901, Sectors: 20837, Isrc: "DEA123400002"},
+		{Number: 3, Offset: 39738, Sectors: 166797, Data: true},
+	},
+}
+
+func openCollection(t *testing.T) *collection.Collection {
+	c, err := collection.Open(filepath.Join(t.TempDir(), "discs.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestAddGet(t *testing.T) {
+	assert := assert.New(t)
+	c := openCollection(t)
+	scannedAt := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+	id, err := c.Add(collection.Scan{Disc: testDisc, Drive: "/dev/sr0", ScannedAt: scannedAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan, err := c.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(collection.Scan{Id: id, Disc: testDisc, Drive: "/dev/sr0", ScannedAt: scannedAt}, scan)
+
+	_, err = c.Get(id + 1)
+	assert.Equal(sql.ErrNoRows, err)
+}
+
+func TestAddDefaultScanTime(t *testing.T) {
+	c := openCollection(t)
+	before := time.Now()
+	id, err := c.Add(collection.Scan{Disc: testDisc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan, err := c.Get(id)
+	if assert.NoError(t, err) {
+		assert.False(t, scan.ScannedAt.Before(before.Truncate(time.Second)))
+	}
+}
+
+func TestFind(t *testing.T) {
+	assert := assert.New(t)
+	c := openCollection(t)
+	other := discid.Snapshot{Id: "lwHl8fGzJyLXQR33ug60E8jhf4k-", FreedbId: "b10aef0c", Tracks: []discid.Track{}}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, disc := range []discid.Snapshot{testDisc, other, testDisc} {
+		scannedAt := start.Add(time.Duration(i) * time.Hour)
+		if _, err := c.Add(collection.Scan{Disc: disc, ScannedAt: scannedAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := c.Count()
+	if assert.NoError(err) {
+		assert.Equal(3, count)
+	}
+	all, err := c.All()
+	if assert.NoError(err) && assert.Len(all, 3) {
+		assert.Equal(other.Id, all[1].Disc.Id)
+	}
+	scans, err := c.FindByDiscId(testDisc.Id)
+	if assert.NoError(err) {
+		assert.Len(scans, 2)
+	}
+	scans, err = c.FindByFreedbId("b10aef0c")
+	if assert.NoError(err) {
+		assert.Len(scans, 1)
+	}
+	scans, err = c.FindByMcn("4006381333931")
+	if assert.NoError(err) {
+		assert.Len(scans, 2)
+	}
+	scans, err = c.FindByIsrc("DEA123400002")
+	if assert.NoError(err) {
+		assert.Len(scans, 2)
+	}
+	scans, err = c.FindByScanTime(start.Add(time.Hour), start.Add(2*time.Hour))
+	if assert.NoError(err) && assert.Len(scans, 1) {
+		assert.Equal(other.Id, scans[0].Disc.Id)
+	}
+	scans, err = c.FindByDiscId("unknown")
+	if assert.NoError(err) {
+		assert.Empty(scans)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	c := openCollection(t)
+	id, err := c.Add(collection.Scan{Disc: testDisc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, c.Delete(id))
+	assert.Equal(t, sql.ErrNoRows, c.Delete(id))
+	scans, err := c.FindByIsrc("DEA123400001")
+	if assert.NoError(t, err) {
+		assert.Empty(t, scans)
+	}
+}
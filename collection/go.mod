@@ -0,0 +1,17 @@
+module github.com/phw/go-discid/collection
+
+go 1.25.0
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.52
+	github.com/phw/go-discid v0.3.0
+	github.com/stretchr/testify v1.8.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/phw/go-discid => ../
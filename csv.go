@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The header of the CSV track tables written by Snapshot.WriteCsv
+var csvHeader = []string{"track", "offset", "length", "isrc"}
+
+// Write the tracks as CSV table.
+//
+// The table has a header row followed by one row per track with the track
+// number, the offset and the length in sectors and the ISRC, e.g.
+//
+//	track,offset,length,isrc
+//	1,150,18751,DEA123400001
+//
+// The disc can be restored from the table with discid.ParseCsv.
+func (s Snapshot) WriteCsv(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, track := range s.Tracks {
+		err := writer.Write([]string{
+			strconv.Itoa(track.Number),
+			strconv.Itoa(track.Offset),
+			strconv.Itoa(track.Sectors),
+			track.Isrc,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Parse a CSV track table as written by Snapshot.WriteCsv and return a
+// Disc instance for it.
+//
+// The header row is required, the columns are matched by name and further
+// columns are ignored. The "isrc" column is optional. The tracks must be
+// numbered consecutively and each track must end where the next one starts.
+// The leadout is the end of the last track.
+func ParseCsv(r io.Reader) (disc Disc, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return disc, errors.New("CSV track table is empty")
+	} else if err != nil {
+		return
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvHeader[:3] {
+		if _, ok := columns[name]; !ok {
+			return disc, fmt.Errorf("CSV track table has no %q column", name)
+		}
+	}
+
+	first := 0
+	offsets := []int{0}
+	isrcs := make(map[int]string)
+	end := 0
+	for line := 2; ; line++ {
+		record, e := reader.Read()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return disc, e
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		var values [3]int
+		for i, name := range csvHeader[:3] {
+			if values[i], e = strconv.Atoi(field(name)); e != nil {
+				return disc, fmt.Errorf("invalid %v in CSV line %v: %q", name, line, field(name))
+			}
+		}
+		number, offset, length := values[0], values[1], values[2]
+		if first == 0 {
+			first = number
+		} else if number != first+len(offsets)-1 {
+			return disc, fmt.Errorf("track numbers in CSV are not consecutive at track %v", number)
+		} else if offset != end {
+			return disc, fmt.Errorf("track %v does not start at the end of the previous track", number)
+		}
+		offsets = append(offsets, offset)
+		end = offset + length
+		if isrc := field("isrc"); isrc != "" {
+			isrcs[number] = isrc
+		}
+	}
+	if first == 0 {
+		return disc, errors.New("CSV track table contains no tracks")
+	}
+
+	offsets[0] = end
+	disc, err = Put(first, offsets)
+	if err != nil {
+		return
+	}
+	disc = Disc{overlayHandle{handle: disc.handle, isrcs: isrcs}}
+	return
+}
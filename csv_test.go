@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestWriteCsv(t *testing.T) {
+	disc, err := discid.Parse("1 2 39738 150 18901")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	s := disc.Snapshot()
+	s.Tracks[0].Isrc = "DEA123400001"
+	var b strings.Builder
+	if err := s.WriteCsv(&b); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "track,offset,length,isrc\n1,150,18751,DEA123400001\n2,18901,20837,\n", b.String())
+
+	restored, err := discid.ParseCsv(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+	assert.Equal(t, s, restored.Snapshot())
+}
+
+func TestParseCsvColumns(t *testing.T) {
+	csv := "Length, Track, Offset, Title\n18751, 1, 150, One\n20837, 2, 18901, Two\n"
+	disc, err := discid.ParseCsv(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "1 2 39738 150 18901", disc.TocString())
+}
+
+func TestParseCsvInvalid(t *testing.T) {
+	for csv, message := range map[string]string{
+		"":                               "CSV track table is empty",
+		"track,offset\n1,150\n":          `CSV track table has no "length" column`,
+		"track,offset,length\n":          "CSV track table contains no tracks",
+		"track,offset,length\n1,x,100\n": `invalid offset in CSV line 2: "x"`,
+		"track,offset,length\n1,150,100\n3,250,100\n": "track numbers in CSV are not consecutive at track 3",
+		"track,offset,length\n1,150,100\n2,300,100\n": "track 2 does not start at the end of the previous track",
+	} {
+		_, err := discid.ParseCsv(strings.NewReader(csv))
+		assert.EqualError(t, err, message, csv)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Parse a cue sheet and return a Disc instance for it.
+//
+// Only cue sheets referencing a single file, as created when ripping a disc
+// to a single image file, are supported. As cue sheets do not contain the
+// length of the disc, the length of the audio file in sectors must be given
+// (e.g. the size of the raw audio data in bytes divided by 2352).
+//
+// Besides the TOC the MCN (CATALOG), the ISRCs and the positions of the
+// sub-indexes 2 and above of each track are read from the cue sheet. Tracks
+// not having the AUDIO type are marked as data tracks.
+func ParseCueSheet(r io.Reader, length int) (disc Disc, err error) {
+	first := 0
+	offsets := []int{0}
+	isrcs := make(map[int]string)
+	indexes := make(map[int][]int)
+	data := make(map[int]bool)
+	mcn := ""
+	files := 0
+	track := 0
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		fields := splitCueLine(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		syntaxErr := fmt.Errorf("invalid cue sheet line %v: %q", lineNum, scanner.Text())
+		switch strings.ToUpper(fields[0]) {
+		case "CATALOG":
+			if len(fields) < 2 {
+				return disc, syntaxErr
+			}
+			mcn = fields[1]
+		case "FILE":
+			files++
+			if files > 1 {
+				return disc, errors.New("cue sheets with multiple files are not supported")
+			}
+		case "TRACK":
+			if len(fields) < 2 {
+				return disc, syntaxErr
+			}
+			n, e := strconv.Atoi(fields[1])
+			if e != nil {
+				return disc, syntaxErr
+			}
+			if first == 0 {
+				first = n
+			} else if n != track+1 {
+				return disc, fmt.Errorf("track numbers in cue sheet are not consecutive at track %v", n)
+			}
+			track = n
+			if len(fields) > 2 && strings.ToUpper(fields[2]) != "AUDIO" {
+				data[track] = true
+			}
+		case "ISRC":
+			if len(fields) < 2 || track == 0 {
+				return disc, syntaxErr
+			}
+			isrcs[track] = fields[1]
+		case "INDEX":
+			if len(fields) < 3 || track == 0 {
+				return disc, syntaxErr
+			}
+			index, e := strconv.Atoi(fields[1])
+			if e != nil {
+				return disc, syntaxErr
+			}
+			position, e := parseMsf(fields[2])
+			if e != nil {
+				return disc, syntaxErr
+			}
+			// The first track starts after the 2 second pregap
+			offset := position + 150
+			switch {
+			case index == 1:
+				offsets = append(offsets, offset)
+			case index > 1:
+				indexes[track] = append(indexes[track], offset)
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if first == 0 || len(offsets)-1 != track-first+1 {
+		return disc, errors.New("cue sheet does not contain an INDEX 01 for every track")
+	}
+
+	offsets[0] = length + 150
+	disc, err = Put(first, offsets)
+	if err != nil {
+		return
+	}
+	disc = Disc{overlayHandle{disc.handle, mcn, isrcs, indexes, data}}
+	return
+}
+
+// Write a cue sheet for an image of the whole disc.
+//
+// The cue sheet references the audio file with the given name and contains
+// the MCN, the ISRCs and the sub-indexes of the tracks. Positions are
+// relative to the start of the file, which does not contain the two second
+// pregap. The disc can be restored from the cue sheet with
+// discid.ParseCueSheet.
+func (s Snapshot) WriteCueSheet(w io.Writer, file string) error {
+	var b strings.Builder
+	if s.Id != "" {
+		fmt.Fprintf(&b, "REM MUSICBRAINZ_DISCID %v\n", s.Id)
+	}
+	if s.FreedbId != "" {
+		fmt.Fprintf(&b, "REM DISCID %v\n", strings.ToUpper(s.FreedbId))
+	}
+	if s.Mcn != "" {
+		fmt.Fprintf(&b, "CATALOG %v\n", s.Mcn)
+	}
+	fmt.Fprintf(&b, "FILE \"%v\" WAVE\n", file)
+	for _, track := range s.Tracks {
+		mode := "AUDIO"
+		if track.Data {
+			mode = "MODE1/2352"
+		}
+		fmt.Fprintf(&b, "  TRACK %02d %v\n", track.Number, mode)
+		if track.Isrc != "" {
+			fmt.Fprintf(&b, "    ISRC %v\n", track.Isrc)
+		}
+		fmt.Fprintf(&b, "    INDEX 01 %v\n", FormatMSF(track.Offset-pregapSectors))
+		for i, index := range track.Indexes {
+			fmt.Fprintf(&b, "    INDEX %02d %v\n", i+2, FormatMSF(index-pregapSectors))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Split a cue sheet line into fields, keeping quoted strings together.
+func splitCueLine(line string) []string {
+	fields := []string{}
+	var field strings.Builder
+	inQuotes := false
+	hasField := false
+	for _, c := range strings.TrimSpace(line) {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+			hasField = true
+		case (c == ' ' || c == '\t') && !inQuotes:
+			if hasField {
+				fields = append(fields, field.String())
+				field.Reset()
+				hasField = false
+			}
+		default:
+			field.WriteRune(c)
+			hasField = true
+		}
+	}
+	if hasField {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// Parse a position in the format MM:SS:FF and return it in sectors.
+func parseMsf(msf string) (int, error) {
+	parts := strings.Split(msf, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid position %q", msf)
+	}
+	values := [3]int{}
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 {
+			return 0, fmt.Errorf("invalid position %q", msf)
+		}
+		values[i] = v
+	}
+	if values[1] > 59 || values[2] > 74 {
+		return 0, fmt.Errorf("invalid position %q", msf)
+	}
+	return (values[0]*60+values[1])*SectorsPerSecond + values[2], nil
+}
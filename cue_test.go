@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestParseCueSheet(t *testing.T) {
+	assert := assert.New(t)
+	f, err := os.Open("testdata/test.cue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	disc, err := discid.ParseCueSheet(f, 90000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal("1 3 90150 150 38550 67700", disc.TocString())
+	assert.Equal("4006381333931", disc.Mcn())
+	track := disc.Track(1)
+	assert.Equal("DEA123400001", track.Isrc)
+	assert.Equal([]int{14420, 22650}, track.Indexes)
+	track = disc.Track(2)
+	assert.Equal("DEA123400002", track.Isrc)
+	assert.Empty(track.Indexes)
+	assert.Equal("", disc.Track(3).Isrc)
+}
+
+func TestWriteCueSheet(t *testing.T) {
+	assert := assert.New(t)
+	f, err := os.Open("testdata/test.cue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	disc, err := discid.ParseCueSheet(f, 90000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	var b strings.Builder
+	if err := disc.Snapshot().WriteCueSheet(&b, "disc.wav"); err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(b.String(), "CATALOG 4006381333931\nFILE \"disc.wav\" WAVE\n"+
+		"  TRACK 01 AUDIO\n    ISRC DEA123400001\n    INDEX 01 00:00:00\n")
+
+	restored, err := discid.ParseCueSheet(strings.NewReader(b.String()), 90000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+	assert.Equal(disc.Snapshot(), restored.Snapshot())
+}
+
+func TestParseCueSheetMultipleFiles(t *testing.T) {
+	cue := `FILE "01.wav" WAVE
+  TRACK 01 AUDIO
+    INDEX 01 00:00:00
+FILE "02.wav" WAVE
+  TRACK 02 AUDIO
+    INDEX 01 00:00:00
+`
+	_, err := discid.ParseCueSheet(strings.NewReader(cue), 90000)
+	assert.EqualError(t, err, "cue sheets with multiple files are not supported")
+}
+
+func TestParseCueSheetMissingIndex(t *testing.T) {
+	cue := `FILE "image.wav" WAVE
+  TRACK 01 AUDIO
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    INDEX 00 03:00:00
+`
+	_, err := discid.ParseCueSheet(strings.NewReader(cue), 90000)
+	assert.EqualError(t, err, "cue sheet does not contain an INDEX 01 for every track")
+}
+
+func TestParseCueSheetInvalidPosition(t *testing.T) {
+	cue := `FILE "image.wav" WAVE
+  TRACK 01 AUDIO
+    INDEX 01 00:00:75
+`
+	_, err := discid.ParseCueSheet(strings.NewReader(cue), 90000)
+	assert.EqualError(t, err, "invalid cue sheet line 3: \"    INDEX 01 00:00:75\"")
+}
+
+func ExampleParseCueSheet() {
+	f, err := os.Open("image.cue")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	// Length of the image in sectors, e.g. the audio data size in bytes / 2352
+	length := 242307
+	disc, err := discid.ParseCueSheet(f, length)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer disc.Close()
+	fmt.Printf("Disc ID: %v\n", disc.Id())
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"io"
+	"regexp"
+)
+
+var dbpowerampTrackRegexp = regexp.MustCompile(
+	`^Track (?P<track>\d+):\s+Ripped LBA (?P<start>\d+) to (?P<end>\d+)`)
+
+// Parse a dBpoweramp CD Ripper log and return a Disc instance for the TOC
+// contained in it.
+//
+// The TOC is read from the "Track N:  Ripped LBA X to Y" lines of the
+// extraction log, so the log must contain all tracks of the disc in order.
+// Data tracks are not included in dBpoweramp logs, for discs with a data
+// session the resulting disc ID matches the one calculated by libdiscid.
+func ParseDbpowerampLog(r io.Reader) (Disc, error) {
+	return parseTrackLines(r, dbpowerampTrackRegexp)
+}
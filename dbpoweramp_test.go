@@ -0,0 +1,54 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestParseDbpowerampLog(t *testing.T) {
+	f, err := os.Open("testdata/dbpoweramp.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	disc, err := discid.ParseDbpowerampLog(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "lSOVc5h6IXSuzcamJS1Gp4_tRuA-", disc.Id())
+	assert.Equal(t,
+		"1 11 242457 150 44942 61305 72755 96360 130485 147315 164275 190702 205412 220437",
+		disc.TocString())
+}
+
+func TestParseDbpowerampLogMissingTrack(t *testing.T) {
+	log := "Track 1:  Ripped LBA 0 to 1000 (0:13) in 0:01. Filename: 1.flac\n" +
+		"Track 3:  Ripped LBA 2000 to 3000 (0:13) in 0:01. Filename: 3.flac\n"
+	_, err := discid.ParseDbpowerampLog(strings.NewReader(log))
+	assert.EqualError(t, err, "track numbers are not consecutive at track 3")
+}
+
+func TestParseDbpowerampLogNoTracks(t *testing.T) {
+	_, err := discid.ParseDbpowerampLog(strings.NewReader("dBpoweramp Release 17.1\n"))
+	assert.EqualError(t, err, "no tracks found")
+}
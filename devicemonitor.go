@@ -0,0 +1,136 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The polling interval used by DeviceMonitor if none is set.
+const DefaultDeviceMonitorInterval = 5 * time.Second
+
+// Watches for disc drives being attached or removed, e.g. USB drives.
+//
+// The device list as returned by discid.ListDevices is refreshed in regular
+// intervals. On Linux the kernel's device events, which are also used by
+// udev, additionally trigger an immediate refresh whenever a block device
+// changes. On macOS disks appearing or disappearing and volumes being
+// mounted or unmounted, as reported by DiskArbitration, trigger a refresh.
+// On Windows devices and media arriving or being removed are reported with
+// WM_DEVICECHANGE. On other platforms the list is only polled.
+type DeviceMonitor struct {
+	// The time between refreshing the device list. Defaults to
+	// DefaultDeviceMonitorInterval.
+	Interval time.Duration
+	// Called with the new list of devices whenever it changed, including
+	// once for the initial list.
+	OnChange func(devices []string)
+	// Called for each newly attached device.
+	OnAttach func(device string)
+	// Called for each removed device.
+	OnDetach func(device string)
+
+	mutex   sync.Mutex
+	devices []string
+	// Replaces ListDevices in tests
+	list func() []string
+}
+
+// Return the current list of devices.
+//
+// Before Run has been called the list is empty.
+func (m *DeviceMonitor) Devices() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]string(nil), m.devices...)
+}
+
+// Watch for device changes until the context gets cancelled.
+//
+// Always returns a non-nil error, which is the error of the context.
+func (m *DeviceMonitor) Run(ctx context.Context) error {
+	interval := m.Interval
+	if interval <= 0 {
+		interval = DefaultDeviceMonitorInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	events := deviceEvents(ctx)
+	initial := true
+	for {
+		m.refresh(initial)
+		initial = false
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-events:
+		}
+	}
+}
+
+// Update the device list and call the callbacks for all changes.
+func (m *DeviceMonitor) refresh(initial bool) {
+	list := m.list
+	if list == nil {
+		list = ListDevices
+	}
+	devices := list()
+
+	m.mutex.Lock()
+	previous := m.devices
+	m.devices = devices
+	m.mutex.Unlock()
+
+	attached := subtractDevices(devices, previous)
+	detached := subtractDevices(previous, devices)
+	if !initial && len(attached) == 0 && len(detached) == 0 {
+		return
+	}
+	if m.OnChange != nil {
+		m.OnChange(devices)
+	}
+	if m.OnDetach != nil {
+		for _, device := range detached {
+			m.OnDetach(device)
+		}
+	}
+	if m.OnAttach != nil {
+		for _, device := range attached {
+			m.OnAttach(device)
+		}
+	}
+}
+
+// Return all devices contained in a, but not in b.
+func subtractDevices(a []string, b []string) []string {
+	result := []string{}
+	for _, device := range a {
+		found := false
+		for _, other := range b {
+			if device == other {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, device)
+		}
+	}
+	return result
+}
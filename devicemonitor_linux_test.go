@@ -0,0 +1,30 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBlockDeviceEvent(t *testing.T) {
+	msg := "add@/devices/pci0000:00/usb1/1-1/host6/target6:0:0/6:0:0:0/block/sr1\x00" +
+		"ACTION=add\x00SUBSYSTEM=block\x00DEVNAME=sr1\x00DEVTYPE=disk\x00"
+	assert.True(t, isBlockDeviceEvent([]byte(msg)))
+	msg = "add@/devices/pci0000:00/usb1/1-1\x00ACTION=add\x00SUBSYSTEM=usb\x00"
+	assert.False(t, isBlockDeviceEvent([]byte(msg)))
+}
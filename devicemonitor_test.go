@@ -0,0 +1,81 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceMonitorRefresh(t *testing.T) {
+	assert := assert.New(t)
+	devices := []string{"/dev/sr0"}
+	changes := [][]string{}
+	attached := []string{}
+	detached := []string{}
+	m := DeviceMonitor{
+		OnChange: func(d []string) { changes = append(changes, d) },
+		OnAttach: func(d string) { attached = append(attached, d) },
+		OnDetach: func(d string) { detached = append(detached, d) },
+		list:     func() []string { return devices },
+	}
+	m.refresh(true)
+	assert.Equal([][]string{{"/dev/sr0"}}, changes)
+	assert.Equal([]string{"/dev/sr0"}, attached)
+
+	m.refresh(false)
+	assert.Len(changes, 1)
+
+	devices = []string{"/dev/sr1"}
+	m.refresh(false)
+	assert.Equal([][]string{{"/dev/sr0"}, {"/dev/sr1"}}, changes)
+	assert.Equal([]string{"/dev/sr0", "/dev/sr1"}, attached)
+	assert.Equal([]string{"/dev/sr0"}, detached)
+	assert.Equal([]string{"/dev/sr1"}, m.Devices())
+}
+
+func TestDeviceMonitorRun(t *testing.T) {
+	var mutex sync.Mutex
+	devices := []string{}
+	attached := make(chan string, 1)
+	m := DeviceMonitor{
+		Interval: 10 * time.Millisecond,
+		OnAttach: func(d string) { attached <- d },
+		list: func() []string {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return devices
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- m.Run(ctx) }()
+	mutex.Lock()
+	devices = []string{"E:"}
+	mutex.Unlock()
+	select {
+	case device := <-attached:
+		assert.Equal(t, "E:", device)
+	case <-time.After(time.Second):
+		t.Fatal("attached device not reported")
+	}
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// Window messages and device events, see winuser.h and dbt.h
+const (
+	wmDestroy               = 0x0002
+	wmClose                 = 0x0010
+	wmDeviceChange          = 0x0219
+	dbtDeviceArrival        = 0x8000
+	dbtDeviceRemoveComplete = 0x8004
+)
+
+var (
+	user32               = syscall.NewLazyDLL("user32.dll")
+	procRegisterClassExW = user32.NewProc("RegisterClassExW")
+	procCreateWindowExW  = user32.NewProc("CreateWindowExW")
+	procDestroyWindow    = user32.NewProc("DestroyWindow")
+	procDefWindowProcW   = user32.NewProc("DefWindowProcW")
+	procGetMessageW      = user32.NewProc("GetMessageW")
+	procDispatchMessageW = user32.NewProc("DispatchMessageW")
+	procPostMessageW     = user32.NewProc("PostMessageW")
+	procPostQuitMessage  = user32.NewProc("PostQuitMessage")
+	procGetModuleHandleW = kernel32.NewProc("GetModuleHandleW")
+)
+
+// The window class of the hidden windows receiving WM_DEVICECHANGE. It is
+// registered only once, as the number of callbacks is limited.
+var (
+	deviceWindowClass     *uint16
+	deviceWindowClassOnce sync.Once
+	// Maps the window handles to the channels of their monitors
+	deviceWindows sync.Map
+)
+
+// WNDCLASSEXW, see winuser.h
+type wndClassEx struct {
+	size       uint32
+	style      uint32
+	wndProc    uintptr
+	clsExtra   int32
+	wndExtra   int32
+	instance   syscall.Handle
+	icon       syscall.Handle
+	cursor     syscall.Handle
+	background syscall.Handle
+	menuName   *uint16
+	className  *uint16
+	iconSm     syscall.Handle
+}
+
+// MSG, see winuser.h
+type windowMsg struct {
+	hwnd    syscall.Handle
+	message uint32
+	wParam  uintptr
+	lParam  uintptr
+	time    uint32
+	x       int32
+	y       int32
+	private uint32
+}
+
+// Return a channel receiving a value whenever Windows reports a device or
+// medium arrival or removal.
+//
+// WM_DEVICECHANGE is only broadcast to top-level windows, hence a hidden
+// window is created with its own message loop. If the window cannot be
+// created nil is returned, which blocks forever when read from.
+func deviceEvents(ctx context.Context) <-chan struct{} {
+	events := make(chan struct{}, 1)
+	created := make(chan syscall.Handle)
+	go func() {
+		// Window messages are delivered to the thread which created the window
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		hwnd := createDeviceWindow()
+		if hwnd == 0 {
+			created <- 0
+			return
+		}
+		deviceWindows.Store(hwnd, events)
+		defer deviceWindows.Delete(hwnd)
+		created <- hwnd
+		var msg windowMsg
+		for {
+			r, _, _ := procGetMessageW.Call(uintptr(unsafe.Pointer(&msg)), 0, 0, 0)
+			if int32(r) == -1 {
+				procDestroyWindow.Call(uintptr(hwnd))
+				return
+			}
+			if r == 0 {
+				return
+			}
+			procDispatchMessageW.Call(uintptr(unsafe.Pointer(&msg)))
+		}
+	}()
+	hwnd := <-created
+	if hwnd == 0 {
+		return nil
+	}
+	go func() {
+		<-ctx.Done()
+		procPostMessageW.Call(uintptr(hwnd), wmClose, 0, 0)
+	}()
+	return events
+}
+
+// Create a hidden window for receiving WM_DEVICECHANGE. Returns 0 on error.
+func createDeviceWindow() syscall.Handle {
+	deviceWindowClassOnce.Do(registerDeviceWindowClass)
+	if deviceWindowClass == nil {
+		return 0
+	}
+	instance, _, _ := procGetModuleHandleW.Call(0)
+	hwnd, _, _ := procCreateWindowExW.Call(0, uintptr(unsafe.Pointer(deviceWindowClass)),
+		0, 0, 0, 0, 0, 0, 0, 0, instance, 0)
+	return syscall.Handle(hwnd)
+}
+
+func registerDeviceWindowClass() {
+	name, err := syscall.UTF16PtrFromString("GoDiscidDeviceMonitor")
+	if err != nil {
+		return
+	}
+	instance, _, _ := procGetModuleHandleW.Call(0)
+	class := wndClassEx{
+		wndProc:   syscall.NewCallback(deviceWindowProc),
+		instance:  syscall.Handle(instance),
+		className: name,
+	}
+	class.size = uint32(unsafe.Sizeof(class))
+	if r, _, _ := procRegisterClassExW.Call(uintptr(unsafe.Pointer(&class))); r != 0 {
+		deviceWindowClass = name
+	}
+}
+
+func deviceWindowProc(hwnd syscall.Handle, message uintptr, wParam uintptr, lParam uintptr) uintptr {
+	switch message {
+	case wmDeviceChange:
+		if wParam == dbtDeviceArrival || wParam == dbtDeviceRemoveComplete {
+			if events, ok := deviceWindows.Load(hwnd); ok {
+				select {
+				case events.(chan struct{}) <- struct{}{}:
+				default:
+				}
+			}
+		}
+		return 1
+	case wmDestroy:
+		procPostQuitMessage.Call(0)
+		return 0
+	}
+	r, _, _ := procDefWindowProcW.Call(uintptr(hwnd), message, wParam, lParam)
+	return r
+}
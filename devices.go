@@ -0,0 +1,237 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Returned by functions reading discs if the system has no disc drive at
+// all. The returned error wraps ErrNoDrive together with the original
+// error, use errors.Is to check for it.
+//
+// This can only be detected on platforms where discid.ListDevices is able
+// to enumerate the drives.
+var ErrNoDrive = errors.New("no disc drive found")
+
+// Returned by functions reading discs if the drive is not ready yet, e.g.
+// because it is still spinning up after a disc was inserted. The returned
+// error wraps ErrNotReady, use errors.Is to check for it.
+//
+// The drive status is currently only available on Linux.
+var ErrNotReady = errors.New("drive not ready")
+
+// Returned by functions reading discs if the drive contains no disc or the
+// tray is open. The returned error wraps ErrNoDisc, use errors.Is to check
+// for it.
+//
+// The drive status is currently only available on Linux and Windows.
+var ErrNoDisc = errors.New("no disc in drive")
+
+// Returned by functions reading discs if the device cannot be opened
+// because of missing permissions. The returned error wraps ErrPermission,
+// use errors.Is to check for it.
+//
+// On Linux the error names the group owning the device if the user is not
+// a member of it. On Windows it hints at the required elevation.
+var ErrPermission = errors.New("permission denied")
+
+// Returned by functions reading discs if the drive is locked by another
+// process, e.g. a burning application. The returned error wraps
+// ErrDriveInUse, use errors.Is to check for it.
+//
+// This is currently only detected on Windows.
+var ErrDriveInUse = errors.New("drive in use by another process")
+
+// Status of a drive as reported by the operating system
+type driveStatus int
+
+const (
+	statusUnknown driveStatus = iota
+	statusNoDisc
+	statusNotReady
+	statusDiscOk
+)
+
+// Return the names of all disc drives found on this system.
+//
+// The returned names can be passed as device to discid.Read and
+// discid.ReadFeatures. If the drives cannot be enumerated on the current
+// platform only the default device, as returned by discid.DefaultDevice, is
+// returned.
+func ListDevices() []string {
+	devices := listDevices()
+	if devices == nil {
+		if device := DefaultDevice(); device != "" {
+			devices = []string{device}
+		}
+	}
+	return devices
+}
+
+// Return a human-readable description of the drive, e.g.
+// "HL-DT-ST DVDRAM GP57EB40".
+//
+// The description consists of the vendor and model of the drive as
+// reported by the operating system, which is currently supported on Linux
+// (sysfs) and macOS (DiskArbitration). An empty string is returned if the
+// drive cannot be identified. If device is empty the default device is
+// described.
+func DeviceDescription(device string) string {
+	device = resolveDeviceName(device)
+	if device == "" {
+		device = DefaultDevice()
+	}
+	if path, err := resolveDevice(device); err == nil {
+		device = path.Node
+	}
+	vendor, model, _ := driveIdentity(device)
+	return strings.TrimSpace(vendor + " " + model)
+}
+
+// Return the glob patterns matching the device nodes of disc drives on the
+// given operating system, following the naming conventions used by
+// libdiscid. On BSD systems the raw device of the whole disc is used.
+func devicePatterns(goos string, goarch string) []string {
+	switch goos {
+	case "freebsd", "dragonfly":
+		return []string{"/dev/cd[0-9]", "/dev/cd[0-9][0-9]"}
+	case "netbsd":
+		// The raw partition is "d" on x86 and "c" on other architectures
+		partition := "c"
+		if goarch == "386" || goarch == "amd64" {
+			partition = "d"
+		}
+		return []string{"/dev/rcd[0-9]" + partition, "/dev/rcd[0-9][0-9]" + partition}
+	case "openbsd":
+		return []string{"/dev/rcd[0-9]c", "/dev/rcd[0-9][0-9]c"}
+	case "solaris", "illumos":
+		return []string{"/vol/dev/aliases/cdrom[0-9]*"}
+	default:
+		return nil
+	}
+}
+
+// Find the disc drives matching the device patterns of the given operating
+// system. nil is returned if the drives cannot be enumerated on the system.
+func globDevices(goos string, goarch string, glob func(pattern string) ([]string, error)) []string {
+	patterns := devicePatterns(goos, goarch)
+	if patterns == nil {
+		return nil
+	}
+	devices := []string{}
+	for _, pattern := range patterns {
+		matches, _ := glob(pattern)
+		devices = append(devices, matches...)
+	}
+	return devices
+}
+
+// Open the tray of the given drive or eject the disc.
+//
+// If device is an empty string the default device is used. Currently this
+// is only implemented on Linux, on other platforms discid.ErrNotSupported is
+// returned.
+func Eject(device string) error {
+	if device == "" {
+		device = DefaultDevice()
+	}
+	return ejectDevice(device)
+}
+
+// Replace err with an error wrapping ErrNoDrive if the enumerated devices
+// show there is no drive. devices is nil if the drives cannot be enumerated.
+func noDriveError(err error, devices []string) error {
+	if err == nil || err == ErrNotSupported || devices == nil || len(devices) > 0 {
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrNoDrive, err)
+}
+
+// Replace err with an error wrapping ErrNoDisc or ErrNotReady depending on
+// the drive status.
+func driveStatusError(err error, status driveStatus) error {
+	if err == nil || err == ErrNotSupported {
+		return err
+	}
+	switch status {
+	case statusNoDisc:
+		return fmt.Errorf("%w: %v", ErrNoDisc, err)
+	case statusNotReady:
+		return fmt.Errorf("%w: %v", ErrNotReady, err)
+	default:
+		return err
+	}
+}
+
+// Replace err with an error wrapping ErrDriveInUse or ErrPermission if
+// accessErr shows the device cannot be opened because it is locked or
+// because of missing permissions. hint explains the missing permissions,
+// e.g. the missing group membership, and might be empty.
+func accessError(err error, accessErr error, hint string) error {
+	if err == nil || err == ErrNotSupported {
+		return err
+	}
+	switch {
+	case errors.Is(accessErr, ErrDriveInUse):
+		return fmt.Errorf("%w: %v", ErrDriveInUse, err)
+	case !errors.Is(accessErr, os.ErrPermission):
+		return err
+	case hint != "":
+		return fmt.Errorf("%w: %v: %v", ErrPermission, hint, err)
+	default:
+		return fmt.Errorf("%w: %v", ErrPermission, err)
+	}
+}
+
+// Capabilities of a disc drive as reported by the operating system
+type DriveCapabilities struct {
+	// The device name as returned by discid.ListDevices
+	Device string `json:"device"`
+	// Maximum read speed as multiple of the single CD speed (150 KB/s),
+	// zero if unknown
+	Speed int `json:"speed"`
+	// Number of disc slots, larger than one for disc changers
+	Slots       int  `json:"slots"`
+	CanOpenTray bool `json:"can_open_tray"`
+	// Slot loading drives and many laptop drives cannot close the tray
+	CanCloseTray        bool `json:"can_close_tray"`
+	CanLockTray         bool `json:"can_lock_tray"`
+	CanChangeSpeed      bool `json:"can_change_speed"`
+	CanReadMultisession bool `json:"can_read_multisession"`
+	// Whether the drive supports reading the Media Catalogue Number
+	CanReadMcn          bool `json:"can_read_mcn"`
+	ReportsMediaChanged bool `json:"reports_media_changed"`
+	CanPlayAudio        bool `json:"can_play_audio"`
+	CanWriteCdR         bool `json:"can_write_cd_r"`
+	CanWriteCdRw        bool `json:"can_write_cd_rw"`
+	CanReadDvd          bool `json:"can_read_dvd"`
+	CanWriteDvdR        bool `json:"can_write_dvd_r"`
+}
+
+// Return the capabilities of all disc drives found on this system.
+//
+// This complements discid.HasFeature, which only reports the features
+// supported by libdiscid on the current platform. Currently this is only
+// implemented on Linux, where the information is read from
+// /proc/sys/dev/cdrom/info. On other platforms discid.ErrNotSupported is
+// returned.
+func ListDriveCapabilities() ([]DriveCapabilities, error) {
+	return listDriveCapabilities()
+}
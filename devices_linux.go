@@ -0,0 +1,233 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const cdromInfoPath = "/proc/sys/dev/cdrom/info"
+
+// ioctl request and results for querying the drive status, see linux/cdrom.h
+const (
+	cdromDriveStatus = 0x5326
+	cdsNoDisc        = 1
+	cdsTrayOpen      = 2
+	cdsDriveNotReady = 3
+	cdsDiscOk        = 4
+)
+
+// ioctl request for ejecting the disc, see linux/cdrom.h
+const cdromEject = 0x5309
+
+func listDevices() []string {
+	names := readCdromInfoDriveNames(cdromInfoPath)
+	if names == nil {
+		matches, _ := filepath.Glob("/dev/sr[0-9]*")
+		for _, match := range matches {
+			names = append(names, filepath.Base(match))
+		}
+	}
+	sort.Strings(names)
+	devices := make([]string, 0, len(names))
+	for _, name := range names {
+		devices = append(devices, "/dev/"+name)
+	}
+	return devices
+}
+
+// Read the kernel's list of CD-ROM drive names, e.g. "sr0".
+func readCdromInfoDriveNames(path string) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	return parseCdromInfo(f)["drive name"]
+}
+
+// Parse the kernel's CD-ROM information table. For each row the label, e.g.
+// "Can read MCN", is mapped to the values of all drives.
+func parseCdromInfo(r io.Reader) map[string][]string {
+	info := make(map[string][]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) == 2 {
+			info[strings.TrimSpace(parts[0])] = strings.Fields(parts[1])
+		}
+	}
+	return info
+}
+
+func listDriveCapabilities() ([]DriveCapabilities, error) {
+	f, err := os.Open(cdromInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readDriveCapabilities(f)
+}
+
+func readDriveCapabilities(r io.Reader) ([]DriveCapabilities, error) {
+	info := parseCdromInfo(r)
+	names := info["drive name"]
+	drives := make([]DriveCapabilities, 0, len(names))
+	for i, name := range names {
+		number := func(label string) int {
+			if values := info[label]; i < len(values) {
+				n, _ := strconv.Atoi(values[i])
+				return n
+			}
+			return 0
+		}
+		flag := func(label string) bool {
+			return number(label) == 1
+		}
+		drives = append(drives, DriveCapabilities{
+			Device:              "/dev/" + name,
+			Speed:               number("drive speed"),
+			Slots:               number("drive # of slots"),
+			CanOpenTray:         flag("Can open tray"),
+			CanCloseTray:        flag("Can close tray"),
+			CanLockTray:         flag("Can lock tray"),
+			CanChangeSpeed:      flag("Can change speed"),
+			CanReadMultisession: flag("Can read multisession"),
+			CanReadMcn:          flag("Can read MCN"),
+			ReportsMediaChanged: flag("Reports media changed"),
+			CanPlayAudio:        flag("Can play audio"),
+			CanWriteCdR:         flag("Can write CD-R"),
+			CanWriteCdRw:        flag("Can write CD-RW"),
+			CanReadDvd:          flag("Can read DVD"),
+			CanWriteDvdR:        flag("Can write DVD-R"),
+		})
+	}
+	sort.Slice(drives, func(i, j int) bool {
+		return drives[i].Device < drives[j].Device
+	})
+	return drives, nil
+}
+
+// Check whether the drive contains a disc by querying the drive status.
+func hasMedia(device string) bool {
+	return readDriveStatus(device) == statusDiscOk
+}
+
+// Query the drive status with the CDROM_DRIVE_STATUS ioctl.
+func readDriveStatus(device string) driveStatus {
+	fd, err := syscall.Open(device, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return statusUnknown
+	}
+	defer syscall.Close(fd)
+	status, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), cdromDriveStatus, 0)
+	if errno != 0 {
+		return statusUnknown
+	}
+	switch status {
+	case cdsNoDisc, cdsTrayOpen:
+		return statusNoDisc
+	case cdsDriveNotReady:
+		return statusNotReady
+	case cdsDiscOk:
+		return statusDiscOk
+	default:
+		return statusUnknown
+	}
+}
+
+// Check whether the device can be opened for reading.
+func checkDeviceAccess(device string) error {
+	fd, err := syscall.Open(device, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return &os.PathError{Op: "open", Path: device, Err: err}
+	}
+	return syscall.Close(fd)
+}
+
+// Name the group owning the device if the user is not a member of it.
+func permissionHint(device string) string {
+	var st syscall.Stat_t
+	if err := syscall.Stat(device, &st); err != nil {
+		return ""
+	}
+	groups, err := os.Getgroups()
+	if err != nil {
+		return ""
+	}
+	return missingGroupHint(device, int(st.Gid), append(groups, os.Getegid()), user.LookupGroupId)
+}
+
+func missingGroupHint(device string, gid int, groups []int, lookupGroup func(gid string) (*user.Group, error)) string {
+	for _, g := range groups {
+		if g == gid {
+			return ""
+		}
+	}
+	name := strconv.Itoa(gid)
+	if group, err := lookupGroup(name); err == nil {
+		name = group.Name
+	}
+	return fmt.Sprintf("user is not a member of the group %q owning %v, add the user to the group and log in again",
+		name, device)
+}
+
+// Eject the disc with the CDROMEJECT ioctl.
+func ejectDevice(device string) error {
+	fd, err := syscall.Open(device, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	defer syscall.Close(fd)
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), cdromEject, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// Check whether the drive is connected via USB or FireWire by resolving
+// its sysfs path.
+func isExternalDevice(device string) bool {
+	path, err := filepath.EvalSymlinks(filepath.Join("/sys/class/block", filepath.Base(device)))
+	if err != nil {
+		return false
+	}
+	return strings.Contains(path, "/usb") || strings.Contains(path, "/firewire")
+}
+
+// Read vendor, model and firmware revision of the drive from sysfs.
+func driveIdentity(device string) (vendor string, model string, revision string) {
+	dir := filepath.Join("/sys/class/block", filepath.Base(device), "device")
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(data))
+	}
+	return read("vendor"), read("model"), read("rev")
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCdromInfoDriveNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "info")
+	info := "CD-ROM information, Id: cdrom.c 3.20 2003/12/17\n\n" +
+		"drive name:\t\tsr1\tsr0\n" +
+		"drive speed:\t\t24\t48\n"
+	if err := ioutil.WriteFile(path, []byte(info), 0644); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"sr1", "sr0"}, readCdromInfoDriveNames(path))
+}
+
+func TestReadCdromInfoDriveNamesMissing(t *testing.T) {
+	assert.Nil(t, readCdromInfoDriveNames("/nonexistent/cdrom/info"))
+}
+
+func TestReadDriveCapabilities(t *testing.T) {
+	info := `CD-ROM information, Id: cdrom.c 3.20 2003/12/17
+
+drive name:		sr1	sr0
+drive speed:		24	48
+drive # of slots:	1	1
+Can close tray:		0	1
+Can open tray:		1	1
+Can lock tray:		1	1
+Can change speed:	1	1
+Can select disk:	0	0
+Can read multisession:	1	1
+Can read MCN:		0	1
+Reports media changed:	1	1
+Can play audio:		1	1
+Can write CD-R:		0	1
+Can write CD-RW:	0	1
+Can read DVD:		0	1
+Can write DVD-R:	0	1
+Can write DVD-RAM:	0	0
+Can read MRW:		0	1
+Can write MRW:		0	1
+Can write RAM:		0	0
+
+`
+	drives, err := readDriveCapabilities(strings.NewReader(info))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []DriveCapabilities{
+		{
+			Device: "/dev/sr0", Speed: 48, Slots: 1,
+			CanOpenTray: true, CanCloseTray: true, CanLockTray: true,
+			CanChangeSpeed: true, CanReadMultisession: true, CanReadMcn: true,
+			ReportsMediaChanged: true, CanPlayAudio: true, CanWriteCdR: true,
+			CanWriteCdRw: true, CanReadDvd: true, CanWriteDvdR: true,
+		},
+		{
+			Device: "/dev/sr1", Speed: 24, Slots: 1,
+			CanOpenTray: true, CanLockTray: true, CanChangeSpeed: true,
+			CanReadMultisession: true, ReportsMediaChanged: true, CanPlayAudio: true,
+		},
+	}, drives)
+}
+
+func TestMissingGroupHint(t *testing.T) {
+	lookupGroup := func(gid string) (*user.Group, error) {
+		return &user.Group{Gid: gid, Name: "cdrom"}, nil
+	}
+	assert.Equal(t, `user is not a member of the group "cdrom" owning /dev/sr0, `+
+		"add the user to the group and log in again",
+		missingGroupHint("/dev/sr0", 24, []int{100, 1000}, lookupGroup))
+	assert.Equal(t, "", missingGroupHint("/dev/sr0", 24, []int{24, 1000}, lookupGroup))
+	unknown := func(gid string) (*user.Group, error) {
+		return nil, user.UnknownGroupIdError(gid)
+	}
+	assert.Contains(t, missingGroupHint("/dev/sr0", 24, nil, unknown), `group "24"`)
+}
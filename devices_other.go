@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package discid
+
+import (
+	"path/filepath"
+	"runtime"
+)
+
+// Drives are enumerated by their device nodes on BSD systems and Solaris.
+// On other systems drive enumeration is not implemented and ListDevices
+// falls back to the default device.
+func listDevices() []string {
+	return globDevices(runtime.GOOS, runtime.GOARCH, filepath.Glob)
+}
+
+func listDriveCapabilities() ([]DriveCapabilities, error) {
+	return nil, ErrNotSupported
+}
+
+// There is no check for the drive status, try reading an audio CD instead.
+func hasMedia(device string) bool {
+	return hasAudio(device)
+}
+
+// The drive status is not available.
+func readDriveStatus(device string) driveStatus {
+	return statusUnknown
+}
+
+// Device access is not checked, the read reports any problems.
+func checkDeviceAccess(device string) error {
+	return nil
+}
+
+// The owner of the device is not checked.
+func permissionHint(device string) string {
+	return ""
+}
+
+func ejectDevice(device string) error {
+	return ErrNotSupported
+}
+
+// External drives are not detected.
+func isExternalDevice(device string) bool {
+	return false
+}
+
+// On macOS vendor and model of the drive are read with DiskArbitration, on
+// other systems they are not detected.
+func driveIdentity(device string) (vendor string, model string, revision string) {
+	return diskArbitrationIdentity(device)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoDriveError(t *testing.T) {
+	readErr := errors.New("cannot open device `/dev/cdrom'")
+	err := noDriveError(readErr, []string{})
+	assert.True(t, errors.Is(err, ErrNoDrive))
+	assert.EqualError(t, err, "no disc drive found: cannot open device `/dev/cdrom'")
+	assert.Equal(t, readErr, noDriveError(readErr, nil))
+	assert.Equal(t, readErr, noDriveError(readErr, []string{"/dev/sr0"}))
+	assert.Equal(t, ErrNotSupported, noDriveError(ErrNotSupported, []string{}))
+	assert.Nil(t, noDriveError(nil, []string{}))
+}
+
+func TestDriveStatusError(t *testing.T) {
+	failure := errors.New("cannot read table of contents")
+	assert.True(t, errors.Is(driveStatusError(failure, statusNoDisc), ErrNoDisc))
+	assert.True(t, errors.Is(driveStatusError(failure, statusNotReady), ErrNotReady))
+	assert.Equal(t, failure, driveStatusError(failure, statusDiscOk))
+	assert.Equal(t, failure, driveStatusError(failure, statusUnknown))
+	assert.Equal(t, ErrNotSupported, driveStatusError(ErrNotSupported, statusNoDisc))
+	assert.Nil(t, driveStatusError(nil, statusNoDisc))
+}
+
+func TestAccessError(t *testing.T) {
+	failure := errors.New("cannot read table of contents")
+	denied := &os.PathError{Op: "open", Path: "/dev/sr0", Err: os.ErrPermission}
+	err := accessError(failure, denied, `user is not a member of the group "cdrom" owning /dev/sr0`)
+	assert.True(t, errors.Is(err, ErrPermission))
+	assert.EqualError(t, err, `permission denied: user is not a member of the group "cdrom" owning /dev/sr0: `+
+		"cannot read table of contents")
+	assert.EqualError(t, accessError(failure, denied, ""), "permission denied: cannot read table of contents")
+	assert.Equal(t, failure, accessError(failure, errors.New("no such device"), ""))
+	assert.Nil(t, accessError(nil, denied, ""))
+	locked := fmt.Errorf("%w: sharing violation", ErrDriveInUse)
+	assert.True(t, errors.Is(accessError(failure, locked, ""), ErrDriveInUse))
+	assert.Equal(t, ErrNotSupported, accessError(ErrNotSupported, locked, ""))
+}
+
+func TestGlobDevices(t *testing.T) {
+	nodes := []string{"/dev/cd0", "/dev/cd1", "/dev/rcd0c", "/dev/rcd0d", "/dev/rcd1c", "/vol/dev/aliases/cdrom0"}
+	glob := func(pattern string) ([]string, error) {
+		matches := []string{}
+		for _, node := range nodes {
+			if ok, _ := filepath.Match(pattern, node); ok {
+				matches = append(matches, node)
+			}
+		}
+		return matches, nil
+	}
+	assert := assert.New(t)
+	assert.Equal([]string{"/dev/cd0", "/dev/cd1"}, globDevices("freebsd", "amd64", glob))
+	assert.Equal([]string{"/dev/rcd0d"}, globDevices("netbsd", "amd64", glob))
+	assert.Equal([]string{"/dev/rcd0c", "/dev/rcd1c"}, globDevices("netbsd", "arm64", glob))
+	assert.Equal([]string{"/dev/rcd0c", "/dev/rcd1c"}, globDevices("openbsd", "amd64", glob))
+	assert.Equal([]string{"/vol/dev/aliases/cdrom0"}, globDevices("solaris", "amd64", glob))
+	assert.Nil(globDevices("darwin", "arm64", glob))
+	assert.Equal([]string{}, globDevices("freebsd", "amd64", func(string) ([]string, error) {
+		return nil, nil
+	}))
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const driveCdrom = 5
+
+// Windows error codes and the ioctl for checking the medium, see winerror.h
+// and winioctl.h
+const (
+	errorNotReady            syscall.Errno = 21
+	errorSharingViolation    syscall.Errno = 32
+	ioctlStorageCheckVerify2               = 0x2d0800
+)
+
+var (
+	kernel32             = syscall.NewLazyDLL("kernel32.dll")
+	procGetLogicalDrives = kernel32.NewProc("GetLogicalDrives")
+	procGetDriveTypeW    = kernel32.NewProc("GetDriveTypeW")
+)
+
+func listDevices() []string {
+	mask, _, _ := procGetLogicalDrives.Call()
+	devices := []string{}
+	for i := uint(0); i < 26; i++ {
+		if mask&(1<<i) == 0 {
+			continue
+		}
+		device := string(rune('A'+i)) + ":"
+		root, err := syscall.UTF16PtrFromString(device + `\`)
+		if err != nil {
+			continue
+		}
+		driveType, _, _ := procGetDriveTypeW.Call(uintptr(unsafe.Pointer(root)))
+		if driveType == driveCdrom {
+			devices = append(devices, device)
+		}
+	}
+	return devices
+}
+
+func listDriveCapabilities() ([]DriveCapabilities, error) {
+	return nil, ErrNotSupported
+}
+
+// There is no check for the drive status, try reading an audio CD instead.
+func hasMedia(device string) bool {
+	return hasAudio(device)
+}
+
+// Query whether the drive has a medium with IOCTL_STORAGE_CHECK_VERIFY2.
+func readDriveStatus(device string) driveStatus {
+	h, err := openDrive(device)
+	if err != nil {
+		return statusUnknown
+	}
+	defer syscall.CloseHandle(h)
+	var returned uint32
+	err = syscall.DeviceIoControl(h, ioctlStorageCheckVerify2, nil, 0, nil, 0, &returned, nil)
+	switch err {
+	case nil:
+		return statusDiscOk
+	case errorNotReady:
+		return statusNoDisc
+	default:
+		return statusUnknown
+	}
+}
+
+// Check whether the drive can be opened for reading. A drive locked by
+// another process results in an error wrapping ErrDriveInUse.
+func checkDeviceAccess(device string) error {
+	h, err := openDrive(device)
+	if err == errorSharingViolation {
+		return fmt.Errorf("%w: %v", ErrDriveInUse, err)
+	} else if err != nil {
+		return &os.PathError{Op: "open", Path: device, Err: err}
+	}
+	return syscall.CloseHandle(h)
+}
+
+// Opening a drive is usually allowed for all users, unless raw access is
+// restricted to administrators by policy.
+func permissionHint(device string) string {
+	return "access to the drive requires elevation, run the application as administrator"
+}
+
+// Open the volume of the drive, e.g. \\.\D: for the device D:.
+func openDrive(device string) (syscall.Handle, error) {
+	path, err := syscall.UTF16PtrFromString(`\\.\` + strings.TrimSuffix(device, `\`))
+	if err != nil {
+		return syscall.InvalidHandle, err
+	}
+	return syscall.CreateFile(path, syscall.GENERIC_READ,
+		syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE, nil, syscall.OPEN_EXISTING, 0, 0)
+}
+
+func ejectDevice(device string) error {
+	return ErrNotSupported
+}
+
+// External drives are not detected.
+func isExternalDevice(device string) bool {
+	return false
+}
+
+// Vendor and model of the drive are not detected.
+func driveIdentity(device string) (vendor string, model string, revision string) {
+	return "", "", ""
+}
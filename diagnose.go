@@ -0,0 +1,225 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Result of a single diagnostic check
+type DiagnosticStatus int
+
+const (
+	DiagnosticOk DiagnosticStatus = iota
+	// The check found a limitation, e.g. a drive not supporting the MCN
+	DiagnosticWarning
+	// The check found a problem preventing discs from being read
+	DiagnosticError
+)
+
+func (s DiagnosticStatus) String() string {
+	switch s {
+	case DiagnosticOk:
+		return "ok"
+	case DiagnosticWarning:
+		return "warning"
+	case DiagnosticError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Encode the status as its name in JSON.
+func (s DiagnosticStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// A single check of a diagnostic report
+type DiagnosticCheck struct {
+	// What was checked, e.g. "libdiscid" or "drive /dev/sr0 access"
+	Name    string           `json:"name"`
+	Status  DiagnosticStatus `json:"status"`
+	Message string           `json:"message"`
+}
+
+// The results of discid.Diagnose
+type DiagnosticReport struct {
+	Checks []DiagnosticCheck `json:"checks"`
+}
+
+// Report whether no check failed with DiagnosticError.
+func (r DiagnosticReport) Ok() bool {
+	for _, check := range r.Checks {
+		if check.Status == DiagnosticError {
+			return false
+		}
+	}
+	return true
+}
+
+// Write the report as plain text with one check per line.
+func (r DiagnosticReport) WriteText(w io.Writer) error {
+	var b strings.Builder
+	for _, check := range r.Checks {
+		fmt.Fprintf(&b, "%-9s %v: %v\n", "["+check.Status.String()+"]", check.Name, check.Message)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Check the environment for reading discs.
+//
+// The report covers the availability and version of libdiscid, the
+// supported features, the disc drives found, whether the drives can be
+// accessed and their capabilities. If probe is true inserted discs are read
+// with all features to check whether the MCN and the ISRCs can be read.
+// Most problems with reading discs are caused by one of these.
+func Diagnose(probe bool) DiagnosticReport {
+	return diagnose(probe, diagnosticEnv{
+		version:        Version,
+		hasFeature:     HasFeature,
+		listDevices:    listDevices,
+		checkAccess:    checkDeviceAccess,
+		permissionHint: permissionHint,
+		driveStatus:    readDriveStatus,
+		capabilities:   ListDriveCapabilities,
+		read:           ReadFeatures,
+	})
+}
+
+// The system functions used by diagnose, replaceable for testing
+type diagnosticEnv struct {
+	version        func() string
+	hasFeature     func(feature Feature) bool
+	listDevices    func() []string
+	checkAccess    func(device string) error
+	permissionHint func(device string) string
+	driveStatus    func(device string) driveStatus
+	capabilities   func() ([]DriveCapabilities, error)
+	read           func(device string, features Feature) (Disc, error)
+}
+
+func diagnose(probe bool, env diagnosticEnv) DiagnosticReport {
+	r := DiagnosticReport{}
+	add := func(name string, status DiagnosticStatus, format string, a ...interface{}) {
+		r.Checks = append(r.Checks, DiagnosticCheck{name, status, fmt.Sprintf(format, a...)})
+	}
+
+	version := env.version()
+	if version == "" {
+		add("libdiscid", DiagnosticError, "not available, this build cannot read discs")
+		return r
+	}
+	add("libdiscid", DiagnosticOk, "%v", version)
+
+	supported := []string{}
+	missing := []string{}
+	for _, f := range []struct {
+		feature Feature
+		name    string
+	}{{FeatureRead, "read"}, {FeatureMcn, "mcn"}, {FeatureIsrc, "isrc"}} {
+		if env.hasFeature(f.feature) {
+			supported = append(supported, f.name)
+		} else {
+			missing = append(missing, f.name)
+		}
+	}
+	if len(missing) > 0 {
+		add("features", DiagnosticWarning, "%v not supported on this platform", strings.Join(missing, ", "))
+	} else {
+		add("features", DiagnosticOk, "%v", strings.Join(supported, ", "))
+	}
+
+	devices := env.listDevices()
+	switch {
+	case devices == nil:
+		add("drives", DiagnosticWarning, "drives cannot be listed on this platform")
+		return r
+	case len(devices) == 0:
+		add("drives", DiagnosticError, "%v", ErrNoDrive)
+		return r
+	}
+	add("drives", DiagnosticOk, "%v", strings.Join(devices, ", "))
+
+	capabilities, _ := env.capabilities()
+	for _, device := range devices {
+		name := "drive " + device
+		if err := env.checkAccess(device); err != nil {
+			if errors.Is(err, os.ErrPermission) {
+				hint := env.permissionHint(device)
+				if hint == "" {
+					hint = "the user might need to be added to the group owning the device"
+				}
+				add(name+" access", DiagnosticError, "permission denied, %v", hint)
+			} else {
+				add(name+" access", DiagnosticError, "%v", err)
+			}
+			continue
+		}
+		add(name+" access", DiagnosticOk, "device can be opened")
+
+		for _, c := range capabilities {
+			if c.Device != device {
+				continue
+			}
+			if c.CanReadMcn {
+				add(name+" capabilities", DiagnosticOk, "can read the MCN")
+			} else {
+				add(name+" capabilities", DiagnosticWarning, "drive reports no support for reading the MCN")
+			}
+		}
+
+		switch env.driveStatus(device) {
+		case statusNoDisc:
+			add(name+" disc", DiagnosticWarning, "%v", ErrNoDisc)
+			continue
+		case statusNotReady:
+			add(name+" disc", DiagnosticWarning, "%v", ErrNotReady)
+			continue
+		}
+		if probe {
+			r.Checks = append(r.Checks, probeDisc(name+" disc", device, env))
+		}
+	}
+	return r
+}
+
+// Read the disc in device with all features and report what was read.
+func probeDisc(name string, device string, env diagnosticEnv) DiagnosticCheck {
+	disc, err := env.read(device, FeatureAll)
+	if err != nil {
+		return DiagnosticCheck{name, DiagnosticError, err.Error()}
+	}
+	defer disc.Close()
+	s := disc.Snapshot()
+	isrcs := 0
+	for _, track := range s.Tracks {
+		if track.Isrc != "" {
+			isrcs++
+		}
+	}
+	mcn := "no MCN"
+	if s.Mcn != "" {
+		mcn = "MCN " + s.Mcn
+	}
+	return DiagnosticCheck{name, DiagnosticOk,
+		fmt.Sprintf("disc ID %v, %v, ISRCs for %v of %v tracks", s.Id, mcn, isrcs, len(s.Tracks))}
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDiagnosticEnv() diagnosticEnv {
+	return diagnosticEnv{
+		version:     func() string { return "libdiscid 0.6.4" },
+		hasFeature:  func(feature Feature) bool { return true },
+		listDevices: func() []string { return []string{"/dev/sr0", "/dev/sr1"} },
+		checkAccess: func(device string) error {
+			if device == "/dev/sr1" {
+				return &os.PathError{Op: "open", Path: device, Err: os.ErrPermission}
+			}
+			return nil
+		},
+		permissionHint: func(device string) string { return "" },
+		driveStatus:    func(device string) driveStatus { return statusDiscOk },
+		capabilities: func() ([]DriveCapabilities, error) {
+			return []DriveCapabilities{{Device: "/dev/sr0", CanReadMcn: false}}, nil
+		},
+		read: func(device string, features Feature) (Disc, error) {
+			return Parse("1 2 206535 150 18901")
+		},
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	assert := assert.New(t)
+	r := diagnose(true, testDiagnosticEnv())
+	assert.False(r.Ok())
+	var b strings.Builder
+	assert.NoError(r.WriteText(&b))
+	assert.Equal(`[ok]      libdiscid: libdiscid 0.6.4
+[ok]      features: read, mcn, isrc
+[ok]      drives: /dev/sr0, /dev/sr1
+[ok]      drive /dev/sr0 access: device can be opened
+[warning] drive /dev/sr0 capabilities: drive reports no support for reading the MCN
+[ok]      drive /dev/sr0 disc: disc ID `+mustParseId(t, "1 2 206535 150 18901")+`, no MCN, ISRCs for 0 of 2 tracks
+[error]   drive /dev/sr1 access: permission denied, the user might need to be added to the group owning the device
+`, b.String())
+}
+
+func TestDiagnoseNoLibdiscid(t *testing.T) {
+	env := testDiagnosticEnv()
+	env.version = func() string { return "" }
+	r := diagnose(true, env)
+	assert.False(t, r.Ok())
+	assert.Len(t, r.Checks, 1)
+	assert.Equal(t, DiagnosticError, r.Checks[0].Status)
+}
+
+func TestDiagnoseNoDisc(t *testing.T) {
+	env := testDiagnosticEnv()
+	env.listDevices = func() []string { return []string{"/dev/sr0"} }
+	env.driveStatus = func(device string) driveStatus { return statusNoDisc }
+	env.read = func(device string, features Feature) (Disc, error) {
+		return Disc{}, errors.New("must not be read")
+	}
+	r := diagnose(true, env)
+	assert.True(t, r.Ok())
+	last := r.Checks[len(r.Checks)-1]
+	assert.Equal(t, DiagnosticCheck{"drive /dev/sr0 disc", DiagnosticWarning, "no disc in drive"}, last)
+}
+
+func mustParseId(t *testing.T, toc string) string {
+	disc, err := Parse(toc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	return disc.Id()
+}
@@ -29,38 +29,44 @@
 // under the terms of the GNU Lesser General Public License version 3 or later.
 package discid
 
-// #cgo LDFLAGS: -ldiscid
-// #include <stdlib.h>
-// #include "discid/discid.h"
-import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
-	"unsafe"
 )
 
 // Platform dependent feature
 //
 // The platform dependent features are currently discid.FeatureRead,
-// discid.FeatureMcn and discid.FeatureIsrc.
+// discid.FeatureMcn and discid.FeatureIsrc. discid.FeatureIndexes is not a
+// libdiscid feature and only supported for SCSI generic devices on Linux.
 //
 // See the libdiscid feature matrix (https://musicbrainz.org/doc/libdiscid#Feature_Matrix)
 // for a list of supported features per platform.
 type Feature uint
 
+// The values match libdiscid's DISCID_FEATURE_* constants.
 const (
 	// Read TOC from disc
-	FeatureRead = C.DISCID_FEATURE_READ
+	FeatureRead = 1 << 0
 	// Read MCN from disc
-	FeatureMcn = C.DISCID_FEATURE_MCN
+	FeatureMcn = 1 << 1
 	// Read ISRCs from disc
-	FeatureIsrc = C.DISCID_FEATURE_ISRC
+	FeatureIsrc = 1 << 2
 	// Read with all features
 	FeatureAll = FeatureRead | FeatureMcn | FeatureIsrc
 )
 
+// Read the positions of the sub-indexes 2 and above from the Q sub-channel,
+// see Track.Indexes. This is not a libdiscid feature and is ignored when
+// reading with libdiscid.
+const FeatureIndexes = 1 << 3
+
+// Returned by functions which are not available on the current platform or
+// in the current build, e.g. reading discs when built without libdiscid.
+var ErrNotSupported = errors.New("not supported on this platform")
+
 // Holds information about a read disc (TOC, MCN, ISRCs).
 //
 // Use discid.Read, discid.ReadFeatures, discid.Put or discid.Parse
@@ -71,37 +77,83 @@ const (
 //	disc := discid.Read("") // Read from default device
 //	defer disc.Close()
 type Disc struct {
-	handle *C.DiscId
+	handle handle
+}
+
+// Provides access to the disc data of a specific backend.
+//
+// The default backend uses libdiscid via cgo. For builds without cgo, e.g.
+// for GOOS=js, a pure Go implementation is used, which can calculate the
+// disc ID for a given TOC but does not support reading discs.
+type handle interface {
+	free()
+	errorMessage() string
+	id() string
+	freedbId() string
+	tocString() string
+	submissionUrl() string
+	firstTrackNum() int
+	lastTrackNum() int
+	sectors() int
+	mcn() string
+	trackOffset(number int) int
+	trackLength(number int) int
+	trackIsrc(number int) string
+	trackIndexes(number int) []int
+	trackIsData(number int) bool
 }
 
 // Holds information about a single track
 type Track struct {
 	// Track number (1-99) of the track
-	Number int
+	Number int `json:"number"`
 	// Start offset in sectors
-	Offset int
+	Offset int `json:"offset"`
 	// Track length in sectors
-	Sectors int
+	Sectors int `json:"sectors"`
 	// ISRC for this track (might be empty).
 	//
 	// This will only bet set if discid.ReadFeatures` is called with discid.FeatureIsrc.
-	Isrc string
+	// It is always empty for data tracks, drives return garbage for them.
+	Isrc string `json:"isrc,omitempty"`
+	// Start offsets in sectors of the sub-indexes 2 and above (might be empty).
+	//
+	// Sub-indexes are available for discs created by discid.ParseCueSheet and
+	// for discs read from SCSI generic devices with discid.FeatureIndexes.
+	Indexes []int `json:"indexes,omitempty"`
+	// True if this is a data track.
+	//
+	// Data tracks are only detected for discs created by discid.ParseCueSheet.
+	// When reading a disc libdiscid already excludes the data session of
+	// multi-session discs (Enhanced CDs) from the TOC.
+	Data bool `json:"data,omitempty"`
 }
 
+// The track number of the leadout as defined by the CD standard (0xAA)
+const LeadoutTrackNumber = 0xAA
+
 // Return the name of the default disc drive for this operating system.
 //
 // The default device is system dependent, e.g. "/dev/cdrom" on Linux and "D:" on Windows.
+//
+// The result is cached after the first call. On systems where the default
+// device depends on the attached drives, e.g. on Windows, use
+// discid.RefreshDefaultDevice to update it after drives changed.
 func DefaultDevice() string {
-	device := C.discid_get_default_device()
-	return C.GoString(device)
+	return defaultDeviceCache.get()
+}
+
+// Query the default device again and return it, see discid.DefaultDevice.
+func RefreshDefaultDevice() string {
+	return defaultDeviceCache.refresh()
 }
 
 // Return version information about libdiscid.
 //
-// The returned string will be e.g. "libdiscid 0.6.2".
+// The returned string will be e.g. "libdiscid 0.6.2". The result is cached
+// after the first call.
 func Version() string {
-	version := C.discid_get_version_string()
-	return C.GoString(version)
+	return versionCache.get()
 }
 
 // Check if a certain feature is implemented on the current platform.
@@ -111,8 +163,7 @@ func Version() string {
 // See the libdiscid feature matrix (https://musicbrainz.org/doc/libdiscid#Feature_Matrix)
 // for a list of supported features per platform.
 func HasFeature(feature Feature) bool {
-	result := C.discid_has_feature(uint32(feature))
-	return result == 1
+	return hasFeature(feature)
 }
 
 // Read the disc in the given CD-ROM/DVD-ROM drive extracting only the TOC.
@@ -141,20 +192,39 @@ func Read(device string) (disc Disc, err error) {
 //
 // Note that reading MCN and ISRC data is significantly slower than just
 // reading the TOC, so only request the features you actually need.
+//
+// If the package was built without libdiscid discid.ErrNotSupported is
+// returned. If the system has no disc drive at all the error wraps
+// discid.ErrNoDrive. If the drive has no disc or is not ready yet the error
+// wraps discid.ErrNoDisc or discid.ErrNotReady. If the device cannot be
+// opened because of missing permissions or because another process locked
+// it the error wraps discid.ErrPermission or discid.ErrDriveInUse. Device
+// aliases registered with discid.RegisterDeviceAlias are accepted as device.
+// On Windows volume GUID paths like `\\?\Volume{GUID}\` are accepted as well,
+// as drive letters are not stable on systems with many removable drives.
+//
+// On Linux SCSI generic devices (/dev/sgN) are accepted as well, e.g. for
+// USB enclosures not providing a block device. These are read by sending
+// the SCSI commands directly without libdiscid.
 func ReadFeatures(device string, features Feature) (disc Disc, err error) {
-	d := Disc{C.discid_new()}
-	var c_device *C.char = nil
-	if device != "" {
-		c_device = C.CString(device)
-		defer C.free(unsafe.Pointer(c_device))
+	device = resolveDeviceName(device)
+	if isSgDevice(device) {
+		return readSgDevice(context.Background(), device, features)
 	}
-	var status = C.discid_read_sparse(d.handle, c_device, C.uint(features))
-	if status == 0 {
-		defer d.Close()
-		err = errors.New(d.ErrorMessage())
-	} else {
-		disc = d
+	h, err := readHandle(device, features&^FeatureIndexes)
+	if err != nil {
+		if err = noDriveError(err, listDevices()); errors.Is(err, ErrNoDrive) {
+			return disc, err
+		}
+		if device == "" {
+			device = defaultDevice()
+		}
+		if accessErr := checkDeviceAccess(device); accessErr != nil {
+			return disc, accessError(err, accessErr, permissionHint(device))
+		}
+		return disc, driveStatusError(err, readDriveStatus(device))
 	}
+	disc = Disc{h}
 	return
 }
 
@@ -169,23 +239,19 @@ func ReadFeatures(device string, features Feature) (disc Disc, err error) {
 // sectors on the disc. offsets must not be longer than 100 elements (leadout + 99 tracks).
 func Put(first int, offsets []int) (disc Disc, err error) {
 	last := first + len(offsets) - 2
-	d := Disc{C.discid_new()}
 	// libdiscid always expects an array of 100 integers, no matter the track count.
-	var c_offsets [100]C.int
-	c_offsets[0] = C.int(offsets[0])
+	var allOffsets [100]int
+	allOffsets[0] = offsets[0]
 	for i, n := range offsets[1:] {
 		track := i + first
 		if track > 99 {
 			break
 		}
-		c_offsets[track] = C.int(n)
+		allOffsets[track] = n
 	}
-	var status = C.discid_put(d.handle, C.int(first), C.int(last), &c_offsets[0])
-	if status == 0 {
-		defer d.Close()
-		err = errors.New(d.ErrorMessage())
-	} else {
-		disc = d
+	h, err := putHandle(first, last, &allOffsets)
+	if err == nil {
+		disc = Disc{h}
 	}
 	return
 }
@@ -196,60 +262,33 @@ func Put(first int, offsets []int) (disc Disc, err error) {
 //
 // This function can be used if you already have a TOC string like e.g.
 // "1 11 242457 150 44942 61305 72755 96360 130485 147315 164275 190702 205412 220437".
+//
+// The values can be separated by any amount of whitespace. The TOC is
+// checked with Toc.ValidateWithOptions, accepting overburned discs, hence
+// the leadout must be greater than the offset of the last track.
 func Parse(toc string) (disc Disc, err error) {
-	first := 0
-	last := 0
-	var offsets [100]int
-	var i int
-	var part string
-	for i, part = range strings.Split(toc, " ") {
-		parsedInt, e := strconv.Atoi(part)
-		if e != nil {
-			err = e
-			return
-		}
-		if i == 0 {
-			first = parsedInt
-		} else if i == 1 {
-			last = parsedInt
-		} else {
-			if i > (last+2) || i > 99+2 {
-				err = errors.New("TOC string contains too many offsets (max. 100)")
-				return
-			}
-			offsets[i-2] = parsedInt
-		}
-	}
-
-	if i < 2 || first < 1 || last < 1 || last > 99 {
-		msg := fmt.Sprintf("Invalid TOC string \"%v\"", toc)
-		err = errors.New(msg)
+	t, err := ParseToc(toc)
+	if err != nil {
 		return
 	}
-
-	offsetCount := i - 2
-	trackCount := last - first + 1
-	if offsetCount < trackCount {
-		msg := fmt.Sprintf("Number of offsets %v does not match track count %v",
-			offsetCount, trackCount)
-		err = errors.New(msg)
+	if err = t.ValidateWithOptions(ValidateOptions{AllowOverburn: true}); err != nil {
 		return
 	}
-
-	return Put(first, offsets[0:trackCount+1])
+	return t.Disc()
 }
 
 // Release the memory allocated for the Disc object.
 func (d Disc) Close() {
-	C.discid_free(d.handle)
+	if d.handle != nil {
+		d.handle.free()
+	}
 }
 
 // Return a human-readable error message.
 //
 // This function may only be used if discid.Read failed.
 func (d Disc) ErrorMessage() string {
-	err := C.discid_get_error_msg(d.handle)
-	return C.GoString(err)
+	return d.handle.errorMessage()
 }
 
 // String representation of the disc, same as Id()
@@ -259,14 +298,12 @@ func (d Disc) String() string {
 
 // Returns the MusicBrainz disc ID.
 func (d Disc) Id() string {
-	id := C.discid_get_id(d.handle)
-	return C.GoString(id)
+	return d.handle.id()
 }
 
 // Returns the FreeDB disc ID.
 func (d Disc) FreedbId() string {
-	id := C.discid_get_freedb_id(d.handle)
-	return C.GoString(id)
+	return d.handle.freedbId()
 }
 
 // Return a string representing CD Table Of Contents (TOC).
@@ -285,37 +322,48 @@ func (d Disc) FreedbId() string {
 //
 // - Up to 99 frame offsets
 func (d Disc) TocString() string {
-	toc := C.discid_get_toc_string(d.handle)
-	return C.GoString(toc)
+	return d.handle.tocString()
+}
+
+// Return the TOC as used for the "toc" parameter of the MusicBrainz web
+// service and the submission URL.
+//
+// This contains the same values as Disc.TocString, but joined by "+", e.g.
+// "1+11+242457+150+44942+61305+72755+96360+130485+147315+164275+190702+205412+220437".
+func (d Disc) MBTocParam() string {
+	return tocParam(d.TocString())
+}
+
+// Convert a TOC string into the "toc" parameter of the MusicBrainz web service.
+func tocParam(toc string) string {
+	return strings.Replace(toc, " ", "+", -1)
 }
 
 // An URL for submitting the DiscID to MusicBrainz.
 func (d Disc) SubmissionUrl() string {
-	url := C.discid_get_submission_url(d.handle)
-	return C.GoString(url)
+	return d.handle.submissionUrl()
 }
 
 // The number of the first track on this disc.
 func (d Disc) FirstTrackNum() int {
-	return int(C.discid_get_first_track_num(d.handle))
+	return d.handle.firstTrackNum()
 }
 
 // The number of the last track on this disc.
 func (d Disc) LastTrackNum() int {
-	return int(C.discid_get_last_track_num(d.handle))
+	return d.handle.lastTrackNum()
 }
 
 // The length of the disc in sectors.
 func (d Disc) Sectors() int {
-	return int(C.discid_get_sectors(d.handle))
+	return d.handle.sectors()
 }
 
 // Return the Media Catalogue Number (MCN) for the disc, if present.
 //
 // This is essentially an EAN (= UPC with 0 prefix).
 func (d Disc) Mcn() string {
-	mcn := C.discid_get_mcn(d.handle)
-	return C.GoString(mcn)
+	return d.handle.mcn()
 }
 
 // Return the Media Catalogue Number (MCN) for the disc, if present.
@@ -330,11 +378,28 @@ func (d Disc) Track(number int) Track {
 			number, first, last)
 		panic(err)
 	}
-	n := C.int(number)
-	return Track{
-		number,
-		int(C.discid_get_track_offset(d.handle, n)),
-		int(C.discid_get_track_length(d.handle, n)),
-		C.GoString(C.discid_get_track_isrc(d.handle, n)),
+	track := Track{
+		Number:  number,
+		Offset:  d.handle.trackOffset(number),
+		Sectors: d.handle.trackLength(number),
+		Indexes: d.handle.trackIndexes(number),
+		Data:    d.handle.trackIsData(number),
 	}
+	if !track.Data {
+		track.Isrc = d.handle.trackIsrc(number)
+	}
+	return track
+}
+
+// Return the leadout as a pseudo-track.
+//
+// The track number is discid.LeadoutTrackNumber and the offset is the
+// leadout offset, which equals the length of the disc in sectors. The track
+// length is always zero.
+func (d Disc) Leadout() Track {
+	return leadoutTrack(d.Sectors())
+}
+
+func leadoutTrack(sectors int) Track {
+	return Track{Number: LeadoutTrackNumber, Offset: sectors}
 }
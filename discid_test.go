@@ -16,6 +16,7 @@
 package discid_test
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -26,7 +27,16 @@ import (
 	"go.uploadedlobster.com/discid"
 )
 
+// Skip tests requiring libdiscid, which is missing for builds without cgo or
+// with the discid_stub build tag.
+func skipWithoutLibdiscid(t *testing.T) {
+	if !discid.HasFeature(discid.FeatureRead) {
+		t.Skip("libdiscid is not available")
+	}
+}
+
 func TestDefaultDevice(t *testing.T) {
+	skipWithoutLibdiscid(t)
 	device := discid.DefaultDevice()
 	if device == "" {
 		t.Errorf("TestDefaultDevice() is empty; expected device name")
@@ -38,6 +48,7 @@ func ExampleDefaultDevice() {
 }
 
 func TestVersion(t *testing.T) {
+	skipWithoutLibdiscid(t)
 	version := discid.Version()
 	if !strings.HasPrefix(version, "libdiscid") {
 		t.Errorf("Version() = %v; expected starting with \"libdiscid\"", version)
@@ -49,6 +60,7 @@ func ExampleVersion() {
 }
 
 func TestHasFeature(t *testing.T) {
+	skipWithoutLibdiscid(t)
 	result := discid.HasFeature(discid.FeatureRead)
 	if !result {
 		t.Errorf("HasFeature() = %v; expected true", result)
@@ -167,6 +179,17 @@ func TestPutTooManyTracks(t *testing.T) {
 	}
 }
 
+func TestMBTocParam(t *testing.T) {
+	disc, err := discid.Parse("1 11 242457 150 44942 61305 72755 96360 130485 147315 164275 190702 205412 220437")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t,
+		"1+11+242457+150+44942+61305+72755+96360+130485+147315+164275+190702+205412+220437",
+		disc.MBTocParam())
+}
+
 func ExamplePut() {
 	first := 1
 	offsets := []int{
@@ -220,22 +243,42 @@ func TestParseNaN(t *testing.T) {
 	toc := "1 2 242457 150 a"
 	_, err := discid.Parse(toc)
 	if assert.Error(t, err) {
-		if err.(*strconv.NumError).Err != strconv.ErrSyntax {
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || numErr.Err != strconv.ErrSyntax {
 			t.Errorf("Expected strconv.ErrSyntax, got \"%v\"", err)
 		}
+		assert.Contains(t, err.Error(), "Invalid offset of track 2 in TOC string")
 	}
 }
 
+func TestParseInvalidField(t *testing.T) {
+	_, err := discid.Parse("1 2 x 150 200")
+	assert.EqualError(t, err, `Invalid leadout in TOC string: strconv.Atoi: parsing "x": invalid syntax`)
+	_, err = discid.Parse("1 -2 242457 150 200")
+	assert.EqualError(t, err, "Invalid last track number in TOC string: value must not be negative")
+}
+
 func TestParseInvalidEmpty(t *testing.T) {
-	toc := ""
-	_, err := discid.Parse(toc)
-	if assert.Error(t, err) {
-		if err.(*strconv.NumError).Err != strconv.ErrSyntax {
-			t.Errorf("Expected strconv.ErrSyntax, got \"%v\"", err)
-		}
+	for _, toc := range []string{"", "   "} {
+		_, err := discid.Parse(toc)
+		assert.EqualError(t, err, fmt.Sprintf("Invalid TOC string %q", toc))
 	}
 }
 
+func TestParseWhitespace(t *testing.T) {
+	disc, err := discid.Parse("  1  2\t39738 150   18901 \n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "1 2 39738 150 18901", disc.TocString())
+}
+
+func TestParseLeadoutNotAfterLastTrack(t *testing.T) {
+	_, err := discid.Parse("1 2 18901 150 18901")
+	assert.EqualError(t, err, "Invalid leadout: 18901 must be greater than the offset 18901 of the last track 2")
+}
+
 func TestParseTooManyOffsets(t *testing.T) {
 	assert := assert.New(t)
 	toc := "1 2 242457 150 200 300"
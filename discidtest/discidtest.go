@@ -0,0 +1,115 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// discidtest provides generators of random TOCs for property-based tests.
+//
+// ValidToc and InvalidToc implement testing/quick.Generator and can be used
+// directly as arguments of the functions checked by quick.Check. The
+// underlying functions RandomToc and RandomInvalidToc only need a
+// *rand.Rand and can be used with other property testing libraries as
+// well.
+package discidtest
+
+import (
+	"math/rand"
+	"reflect"
+
+	discid "github.com/phw/go-discid"
+)
+
+// The minimum track length of the Red Book (4 seconds) and the pregap
+// before the first track.
+const (
+	minTrackSectors = 4 * discid.SectorsPerSecond
+	pregapSectors   = 2 * discid.SectorsPerSecond
+)
+
+// A random TOC passing discid.Toc.Validate
+type ValidToc struct {
+	discid.Toc
+}
+
+// Implement quick.Generator.
+func (ValidToc) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(ValidToc{RandomToc(r)})
+}
+
+// A random TOC failing discid.Toc.Validate, which differs from a valid TOC
+// in only one aspect
+type InvalidToc struct {
+	discid.Toc
+}
+
+// Implement quick.Generator.
+func (InvalidToc) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(InvalidToc{RandomInvalidToc(r)})
+}
+
+// Return a random TOC passing discid.Toc.Validate.
+//
+// Most TOCs start with track 1 like real discs, but other first track
+// numbers occur as well. Tracks are at least 4 seconds long and the disc
+// does not exceed discid.MaxRedBookSectors.
+func RandomToc(r *rand.Rand) discid.Toc {
+	first := 1
+	if r.Intn(10) == 0 {
+		first = 1 + r.Intn(99)
+	}
+	count := 1 + r.Intn(100-first)
+	// Distribute the remaining sectors randomly on top of the minimum
+	// length of each track
+	spare := (discid.MaxRedBookSectors - pregapSectors - count*minTrackSectors) / count
+	offset := pregapSectors
+	toc := discid.Toc{First: first, Last: first + count - 1, TrackOffsets: []int{}}
+	for i := 0; i < count; i++ {
+		toc.TrackOffsets = append(toc.TrackOffsets, offset)
+		offset += minTrackSectors + r.Intn(spare+1)
+	}
+	toc.Leadout = offset
+	return toc
+}
+
+// Return a random TOC failing discid.Toc.Validate.
+//
+// The TOC is created by RandomToc and changed in one aspect, e.g. two
+// offsets are swapped, the last track starts after the leadout, the disc
+// is overburned, an offset is missing or a track number is out of range.
+func RandomInvalidToc(r *rand.Rand) discid.Toc {
+	toc := RandomToc(r)
+	count := len(toc.TrackOffsets)
+	mutation := r.Intn(6)
+	if mutation == 0 && count < 2 {
+		mutation = 1 + r.Intn(5)
+	}
+	switch mutation {
+	case 0:
+		i := r.Intn(count - 1)
+		toc.TrackOffsets[i], toc.TrackOffsets[i+1] = toc.TrackOffsets[i+1], toc.TrackOffsets[i]
+	case 1:
+		toc.Leadout = toc.TrackOffsets[count-1] - 1 - r.Intn(minTrackSectors)
+	case 2:
+		toc.Leadout = discid.MaxRedBookSectors + 1 + r.Intn(20*60*discid.SectorsPerSecond)
+	case 3:
+		i := r.Intn(count)
+		toc.TrackOffsets = append(toc.TrackOffsets[:i], toc.TrackOffsets[i+1:]...)
+	case 4:
+		toc.First = 0
+		toc.Last = count - 1
+	case 5:
+		toc.First = 101 - count
+		toc.Last = 100
+	}
+	return toc
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discidtest_test
+
+import (
+	"testing"
+	"testing/quick"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/discidtest"
+)
+
+func TestValidToc(t *testing.T) {
+	err := quick.Check(func(toc discidtest.ValidToc) bool {
+		if toc.Validate() != nil {
+			return false
+		}
+		parsed, err := discid.ParseToc(toc.String())
+		if err != nil || parsed.String() != toc.String() {
+			return false
+		}
+		disc, err := toc.Disc()
+		if err != nil {
+			return false
+		}
+		defer disc.Close()
+		return disc.Id() == toc.DiscId() && disc.FreedbId() == toc.FreedbId()
+	}, nil)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInvalidToc(t *testing.T) {
+	err := quick.Check(func(toc discidtest.InvalidToc) bool {
+		return toc.Validate() != nil
+	}, &quick.Config{MaxCount: 1000})
+	if err != nil {
+		t.Error(err)
+	}
+}
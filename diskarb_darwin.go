@@ -0,0 +1,150 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build darwin && cgo
+// +build darwin,cgo
+
+package discid
+
+// #cgo LDFLAGS: -framework DiskArbitration -framework CoreFoundation
+// #include <stdlib.h>
+// #include <dispatch/dispatch.h>
+// #include <DiskArbitration/DiskArbitration.h>
+//
+// extern void goDiskChanged(void);
+//
+// static void copyDescriptionString(CFDictionaryRef desc, CFStringRef key, char *buf, size_t len) {
+//	buf[0] = 0;
+//	CFTypeRef value = CFDictionaryGetValue(desc, key);
+//	if (value != NULL && CFGetTypeID(value) == CFStringGetTypeID()) {
+//		CFStringGetCString((CFStringRef)value, buf, len, kCFStringEncodingUTF8);
+//	}
+// }
+//
+// static int diskIdentity(const char *bsdName, char *vendor, char *model, char *revision, size_t len) {
+//	DASessionRef session = DASessionCreate(kCFAllocatorDefault);
+//	if (session == NULL) {
+//		return 0;
+//	}
+//	DADiskRef disk = DADiskCreateFromBSDName(kCFAllocatorDefault, session, bsdName);
+//	if (disk == NULL) {
+//		CFRelease(session);
+//		return 0;
+//	}
+//	CFDictionaryRef desc = DADiskCopyDescription(disk);
+//	int found = desc != NULL;
+//	if (found) {
+//		copyDescriptionString(desc, kDADiskDescriptionDeviceVendorKey, vendor, len);
+//		copyDescriptionString(desc, kDADiskDescriptionDeviceModelKey, model, len);
+//		copyDescriptionString(desc, kDADiskDescriptionDeviceRevisionKey, revision, len);
+//		CFRelease(desc);
+//	}
+//	CFRelease(disk);
+//	CFRelease(session);
+//	return found;
+// }
+//
+// static void diskChanged(DADiskRef disk, void *context) {
+//	goDiskChanged();
+// }
+//
+// static void diskDescriptionChanged(DADiskRef disk, CFArrayRef keys, void *context) {
+//	goDiskChanged();
+// }
+//
+// static void *startDiskEvents(void) {
+//	DASessionRef session = DASessionCreate(kCFAllocatorDefault);
+//	if (session == NULL) {
+//		return NULL;
+//	}
+//	DARegisterDiskAppearedCallback(session, NULL, diskChanged, NULL);
+//	DARegisterDiskDisappearedCallback(session, NULL, diskChanged, NULL);
+//	DARegisterDiskDescriptionChangedCallback(session, NULL,
+//		kDADiskDescriptionWatchVolumePath, diskDescriptionChanged, NULL);
+//	DASessionSetDispatchQueue(session, dispatch_get_global_queue(DISPATCH_QUEUE_PRIORITY_DEFAULT, 0));
+//	return (void *)session;
+// }
+//
+// static void stopDiskEvents(void *session) {
+//	DASessionSetDispatchQueue((DASessionRef)session, NULL);
+//	CFRelease(session);
+// }
+import "C"
+import (
+	"context"
+	"sync"
+	"unsafe"
+)
+
+// Read vendor, model and firmware revision of the drive from the disk
+// description provided by DiskArbitration.
+func diskArbitrationIdentity(device string) (vendor string, model string, revision string) {
+	number, ok := darwinDiskNumber(RawDevice(device))
+	if !ok {
+		return "", "", ""
+	}
+	bsdName := C.CString("disk" + number)
+	defer C.free(unsafe.Pointer(bsdName))
+	const size = 256
+	buf := (*[3 * size]C.char)(C.malloc(3 * size))
+	defer C.free(unsafe.Pointer(buf))
+	if C.diskIdentity(bsdName, &buf[0], &buf[size], &buf[2*size], size) == 0 {
+		return "", "", ""
+	}
+	return C.GoString(&buf[0]), C.GoString(&buf[size]), C.GoString(&buf[2*size])
+}
+
+// The channels notified by goDiskChanged
+var diskListeners = struct {
+	sync.Mutex
+	channels map[chan struct{}]bool
+}{channels: make(map[chan struct{}]bool)}
+
+// Return a channel receiving a value whenever DiskArbitration reports a
+// disk appearing or disappearing, e.g. a drive being attached or a disc
+// being inserted, or a volume being mounted or unmounted.
+//
+// If no DiskArbitration session can be created nil is returned, which
+// blocks forever when read from.
+func diskArbitrationEvents(ctx context.Context) <-chan struct{} {
+	session := C.startDiskEvents()
+	if session == nil {
+		return nil
+	}
+	events := make(chan struct{}, 1)
+	diskListeners.Lock()
+	diskListeners.channels[events] = true
+	diskListeners.Unlock()
+	go func() {
+		<-ctx.Done()
+		C.stopDiskEvents(session)
+		diskListeners.Lock()
+		delete(diskListeners.channels, events)
+		diskListeners.Unlock()
+	}()
+	return events
+}
+
+// Notify all listeners without blocking.
+func notifyDiskListeners() {
+	diskListeners.Lock()
+	defer diskListeners.Unlock()
+	for events := range diskListeners.channels {
+		select {
+		case events <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !darwin || !cgo
+// +build !darwin !cgo
+
+package discid
+
+import "context"
+
+// DiskArbitration is only available on macOS.
+func diskArbitrationIdentity(device string) (vendor string, model string, revision string) {
+	return "", "", ""
+}
+
+func diskArbitrationEvents(ctx context.Context) <-chan struct{} {
+	return nil
+}
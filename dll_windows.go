@@ -0,0 +1,250 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build discid_dll && !discid_stub
+// +build discid_dll,!discid_stub
+
+package discid
+
+// Loads libdiscid at runtime from discid.dll instead of linking against it
+// with cgo. This allows building on Windows without cgo and the libdiscid
+// development files. discid.dll must be available in the DLL search path,
+// e.g. next to the executable.
+//
+// Enable with the build tag discid_dll, e.g.:
+//
+//	go build -tags discid_dll
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	libdiscid = syscall.NewLazyDLL("discid.dll")
+
+	procNew              = libdiscid.NewProc("discid_new")
+	procFree             = libdiscid.NewProc("discid_free")
+	procReadSparse       = libdiscid.NewProc("discid_read_sparse")
+	procPut              = libdiscid.NewProc("discid_put")
+	procGetErrorMsg      = libdiscid.NewProc("discid_get_error_msg")
+	procGetId            = libdiscid.NewProc("discid_get_id")
+	procGetFreedbId      = libdiscid.NewProc("discid_get_freedb_id")
+	procGetTocString     = libdiscid.NewProc("discid_get_toc_string")
+	procGetSubmissionUrl = libdiscid.NewProc("discid_get_submission_url")
+	procGetDefaultDevice = libdiscid.NewProc("discid_get_default_device")
+	procGetFirstTrackNum = libdiscid.NewProc("discid_get_first_track_num")
+	procGetLastTrackNum  = libdiscid.NewProc("discid_get_last_track_num")
+	procGetSectors       = libdiscid.NewProc("discid_get_sectors")
+	procGetTrackOffset   = libdiscid.NewProc("discid_get_track_offset")
+	procGetTrackLength   = libdiscid.NewProc("discid_get_track_length")
+	procGetMcn           = libdiscid.NewProc("discid_get_mcn")
+	procGetTrackIsrc     = libdiscid.NewProc("discid_get_track_isrc")
+	procHasFeature       = libdiscid.NewProc("discid_has_feature")
+	procGetVersionString = libdiscid.NewProc("discid_get_version_string")
+
+	loadOnce sync.Once
+	loadErr  error
+)
+
+// Load discid.dll and resolve all required functions.
+func loadDll() error {
+	loadOnce.Do(func() {
+		if err := libdiscid.Load(); err != nil {
+			loadErr = fmt.Errorf("discid.dll could not be loaded: %v", err)
+			return
+		}
+		procs := []*syscall.LazyProc{
+			procNew, procFree, procReadSparse, procPut, procGetErrorMsg,
+			procGetId, procGetFreedbId, procGetTocString, procGetSubmissionUrl,
+			procGetDefaultDevice, procGetFirstTrackNum, procGetLastTrackNum,
+			procGetSectors, procGetTrackOffset, procGetTrackLength, procGetMcn,
+			procGetTrackIsrc, procHasFeature, procGetVersionString,
+		}
+		for _, proc := range procs {
+			if err := proc.Find(); err != nil {
+				loadErr = fmt.Errorf("discid.dll is not a supported libdiscid version: %v", err)
+				return
+			}
+		}
+	})
+	return loadErr
+}
+
+// Convert a NUL terminated C string returned by libdiscid.
+func goString(p uintptr) string {
+	if p == 0 {
+		return ""
+	}
+	ptr := *(**byte)(unsafe.Pointer(&p))
+	var b []byte
+	for i := uintptr(0); ; i++ {
+		c := *(*byte)(unsafe.Pointer(uintptr(unsafe.Pointer(ptr)) + i))
+		if c == 0 {
+			break
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// Disc data backed by discid.dll
+type dllHandle struct {
+	p uintptr
+}
+
+func defaultDevice() string {
+	if loadDll() != nil {
+		return ""
+	}
+	device, _, _ := procGetDefaultDevice.Call()
+	return goString(device)
+}
+
+func version() string {
+	if loadDll() != nil {
+		return ""
+	}
+	version, _, _ := procGetVersionString.Call()
+	return goString(version)
+}
+
+func hasFeature(feature Feature) bool {
+	if loadDll() != nil {
+		return false
+	}
+	result, _, _ := procHasFeature.Call(uintptr(feature))
+	return int32(result) == 1
+}
+
+func newDllHandle() (*dllHandle, error) {
+	if err := loadDll(); err != nil {
+		return nil, err
+	}
+	p, _, _ := procNew.Call()
+	return &dllHandle{p}, nil
+}
+
+func readHandle(device string, features Feature) (handle, error) {
+	h, err := newDllHandle()
+	if err != nil {
+		return nil, err
+	}
+	var c_device *byte = nil
+	if device != "" {
+		c_device, err = syscall.BytePtrFromString(device)
+		if err != nil {
+			h.free()
+			return nil, err
+		}
+	}
+	status, _, _ := procReadSparse.Call(h.p, uintptr(unsafe.Pointer(c_device)), uintptr(features))
+	if int32(status) == 0 {
+		defer h.free()
+		return nil, errors.New(h.errorMessage())
+	}
+	return h, nil
+}
+
+func putHandle(first int, last int, offsets *[100]int) (handle, error) {
+	h, err := newDllHandle()
+	if err != nil {
+		return nil, err
+	}
+	var c_offsets [100]int32
+	for i, n := range offsets {
+		c_offsets[i] = int32(n)
+	}
+	status, _, _ := procPut.Call(h.p, uintptr(first), uintptr(last), uintptr(unsafe.Pointer(&c_offsets[0])))
+	if int32(status) == 0 {
+		defer h.free()
+		return nil, errors.New(h.errorMessage())
+	}
+	return h, nil
+}
+
+func (h *dllHandle) free() {
+	procFree.Call(h.p)
+}
+
+func (h *dllHandle) callString(proc *syscall.LazyProc, args ...uintptr) string {
+	r, _, _ := proc.Call(append([]uintptr{h.p}, args...)...)
+	return goString(r)
+}
+
+func (h *dllHandle) callInt(proc *syscall.LazyProc, args ...uintptr) int {
+	r, _, _ := proc.Call(append([]uintptr{h.p}, args...)...)
+	return int(int32(r))
+}
+
+func (h *dllHandle) errorMessage() string {
+	return h.callString(procGetErrorMsg)
+}
+
+func (h *dllHandle) id() string {
+	return h.callString(procGetId)
+}
+
+func (h *dllHandle) freedbId() string {
+	return h.callString(procGetFreedbId)
+}
+
+func (h *dllHandle) tocString() string {
+	return h.callString(procGetTocString)
+}
+
+func (h *dllHandle) submissionUrl() string {
+	return h.callString(procGetSubmissionUrl)
+}
+
+func (h *dllHandle) firstTrackNum() int {
+	return h.callInt(procGetFirstTrackNum)
+}
+
+func (h *dllHandle) lastTrackNum() int {
+	return h.callInt(procGetLastTrackNum)
+}
+
+func (h *dllHandle) sectors() int {
+	return h.callInt(procGetSectors)
+}
+
+func (h *dllHandle) mcn() string {
+	return h.callString(procGetMcn)
+}
+
+func (h *dllHandle) trackOffset(number int) int {
+	return h.callInt(procGetTrackOffset, uintptr(number))
+}
+
+func (h *dllHandle) trackLength(number int) int {
+	return h.callInt(procGetTrackLength, uintptr(number))
+}
+
+func (h *dllHandle) trackIsrc(number int) string {
+	return h.callString(procGetTrackIsrc, uintptr(number))
+}
+
+// Sub-indexes cannot be read with libdiscid.
+func (h *dllHandle) trackIndexes(number int) []int {
+	return nil
+}
+
+func (h *dllHandle) trackIsData(number int) bool {
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Type of a ReadEvent
+type ReadEventType int
+
+const (
+	// The read of the device started. libdiscid opens the device as part
+	// of reading the TOC.
+	EventDeviceOpened ReadEventType = iota + 1
+	// The TOC was read
+	EventTocRead
+	// The MCN was read, see ReadEvent.Mcn
+	EventMcnRead
+	// The ISRC of a track was read, see ReadEvent.Track and ReadEvent.Isrc
+	EventIsrcRead
+	// The read finished successfully
+	EventCompleted
+	// The read failed, see ReadEvent.Err
+	EventFailed
+)
+
+func (t ReadEventType) String() string {
+	switch t {
+	case EventDeviceOpened:
+		return "device opened"
+	case EventTocRead:
+		return "TOC read"
+	case EventMcnRead:
+		return "MCN read"
+	case EventIsrcRead:
+		return "ISRC read"
+	case EventCompleted:
+		return "completed"
+	case EventFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// An event emitted during a read with discid.ReadWithOptions
+type ReadEvent struct {
+	Type ReadEventType
+	// The device being read
+	Device string
+	// The TOC string for EventTocRead
+	Toc string
+	// The MCN for EventMcnRead, might be empty
+	Mcn string
+	// The track number and its ISRC for EventIsrcRead. The ISRC might be
+	// empty.
+	Track int
+	Isrc  string
+	// The error for EventFailed
+	Err error
+}
+
+// Send the event to the callback in opts, if set.
+func (opts ReadOptions) emit(event ReadEvent) {
+	if opts.Events != nil {
+		opts.Events(event)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPhasesEvents(t *testing.T) {
+	events := []ReadEvent{}
+	opts := ReadOptions{
+		Features: FeatureAll,
+		Events:   func(e ReadEvent) { events = append(events, e) },
+	}
+	disc, err := readPhases("/dev/sr0", opts, fakePhaseRead(t, "1 2 34567 150 10000", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, []ReadEvent{
+		{Type: EventDeviceOpened, Device: "/dev/sr0"},
+		{Type: EventTocRead, Device: "/dev/sr0", Toc: "1 2 34567 150 10000"},
+		{Type: EventMcnRead, Device: "/dev/sr0", Mcn: "4006381333931"},
+		{Type: EventIsrcRead, Device: "/dev/sr0", Track: 1, Isrc: "DEA123400001"},
+		{Type: EventIsrcRead, Device: "/dev/sr0", Track: 2, Isrc: "DEA123400002"},
+	}, events)
+}
+
+func TestReadWithOptionsFailedEvent(t *testing.T) {
+	events := []ReadEvent{}
+	opts := ReadOptions{Events: func(e ReadEvent) { events = append(events, e) }}
+	_, err := ReadWithOptions("notadevice", opts)
+	assert.Error(t, err)
+	if assert.NotEmpty(t, events) {
+		last := events[len(events)-1]
+		assert.Equal(t, EventFailed, last.Type)
+		assert.Equal(t, err, last.Err)
+	}
+}
+
+func TestReadEventTypeString(t *testing.T) {
+	assert.Equal(t, "ISRC read", EventIsrcRead.String())
+	assert.Equal(t, "unknown", ReadEventType(0).String())
+}
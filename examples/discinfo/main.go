@@ -5,6 +5,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 
 	"go.uploadedlobster.com/discid"
 )
@@ -25,12 +26,5 @@ func main() {
 	fmt.Printf("First track   : %v\n", disc.FirstTrackNum())
 	fmt.Printf("Last track    : %v\n", disc.LastTrackNum())
 	fmt.Printf("Sectors       : %v\n\n", disc.Sectors())
-
-	for n := disc.FirstTrackNum(); n <= disc.LastTrackNum(); n++ {
-		track := disc.Track(n)
-		fmt.Printf("Track #%v:\n", track.Number)
-		fmt.Printf("    ISRC   : %v\n", track.Isrc)
-		fmt.Printf("    Offset : %v\n", track.Offset)
-		fmt.Printf("    Sectors: %v\n", track.Sectors)
-	}
+	disc.FormatTable(os.Stdout)
 }
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Calculate the FreeDB (CDDB) disc ID.
+//
+// offsets[0] is the leadout, offsets[n] the offset of track n. This mirrors
+// libdiscid, which always sums the offsets starting at track 1 and uses the
+// last track number instead of the track count, so that both agree for discs
+// where the first track number is greater than 1.
+func calculateFreedbId(first int, last int, offsets *[100]int) string {
+	n := 0
+	for i := 1; i <= last; i++ {
+		n += cddbSum(offsets[i] / SectorsPerSecond)
+	}
+	t := offsets[0]/SectorsPerSecond - offsets[1]/SectorsPerSecond
+	return fmt.Sprintf("%08x", (n%0xff)<<24|t<<8|last)
+}
+
+// Return the sum of the decimal digits of n.
+func cddbSum(n int) int {
+	sum := 0
+	for n > 0 {
+		sum += n % 10
+		n /= 10
+	}
+	return sum
+}
+
+// Check the FreeDB ID returned by the backend against the value calculated
+// in Go.
+//
+// An error is returned if both values differ. This is a safety net for
+// backends other than libdiscid and for validating builds on new platforms.
+func (d Disc) VerifyFreedbId() error {
+	h, err := d.goTocHandle()
+	if err != nil {
+		return err
+	}
+	expected := h.freedbId()
+	if actual := d.FreedbId(); actual != expected {
+		return fmt.Errorf("FreeDB ID mismatch: backend returned %q, expected %q", actual, expected)
+	}
+	return nil
+}
+
+// Return the disc information in the output format of the cd-discid tool.
+//
+// The output consists of the FreeDB ID, the number of tracks, the offsets of
+// all tracks in sectors and the total length of the disc in seconds, all
+// separated by spaces, e.g. "830abf0a 10 150 18901 39738 59557 79152 100126
+// 124833 147278 166336 182560 2753". This is the format expected by scripts
+// like abcde. No trailing newline is included.
+func (d Disc) CdDiscidString() string {
+	offsets := []int{}
+	for i := d.FirstTrackNum(); i <= d.LastTrackNum(); i++ {
+		offsets = append(offsets, d.handle.trackOffset(i))
+	}
+	return cdDiscidString(d.FreedbId(), offsets, d.Sectors())
+}
+
+// Return the snapshot's disc information in the output format of the
+// cd-discid tool, see Disc.CdDiscidString.
+func (s Snapshot) CdDiscidString() string {
+	offsets := []int{}
+	for _, track := range s.Tracks {
+		offsets = append(offsets, track.Offset)
+	}
+	return cdDiscidString(s.FreedbId, offsets, s.Sectors)
+}
+
+func cdDiscidString(freedbId string, offsets []int, sectors int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %d", freedbId, len(offsets))
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, " %d", offset)
+	}
+	fmt.Fprintf(&b, " %d", sectors/SectorsPerSecond)
+	return b.String()
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateFreedbId(t *testing.T) {
+	offsets := [100]int{
+		206535, 150, 18901, 39738, 59557, 79152, 100126, 124833, 147278, 166336, 182560,
+	}
+	assert.Equal(t, "830abf0a", calculateFreedbId(1, 10, &offsets))
+}
+
+func TestCalculateFreedbIdFirstTrackLargerOne(t *testing.T) {
+	offsets := [100]int{
+		206535, 0, 0, 150, 18901, 39738, 59557, 79152, 100126, 124833, 147278, 166336, 182560,
+	}
+	assert.Equal(t, "830ac10c", calculateFreedbId(3, 12, &offsets))
+}
+
+func TestCddbSum(t *testing.T) {
+	assert.Equal(t, 0, cddbSum(0))
+	assert.Equal(t, 6, cddbSum(123))
+}
+
+func TestVerifyFreedbId(t *testing.T) {
+	disc, err := Parse("1 10 206535 150 18901 39738 59557 79152 100126 124833 147278 166336 182560")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.NoError(t, disc.VerifyFreedbId())
+	disc = Disc{freedbOverride{disc.handle, "830abf0b"}}
+	assert.EqualError(t, disc.VerifyFreedbId(),
+		`FreeDB ID mismatch: backend returned "830abf0b", expected "830abf0a"`)
+}
+
+func TestCdDiscidString(t *testing.T) {
+	disc, err := Parse("1 10 206535 150 18901 39738 59557 79152 100126 124833 147278 166336 182560")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t,
+		"830abf0a 10 150 18901 39738 59557 79152 100126 124833 147278 166336 182560 2753",
+		disc.CdDiscidString())
+	assert.Equal(t, disc.CdDiscidString(), disc.Snapshot().CdDiscidString())
+}
+
+type freedbOverride struct {
+	handle
+	value string
+}
+
+func (h freedbOverride) freedbId() string {
+	return h.value
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// Check if s is a syntactically valid MusicBrainz disc ID.
+//
+// A disc ID is the SHA-1 hash of the TOC encoded with a modified base64
+// alphabet, which uses ".", "_" and "-" instead of "+", "/" and "=". A valid
+// disc ID has 28 characters, ends with a single "-" and must decode to
+// exactly 20 bytes without any unused bits set,
+// e.g. "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-".
+func IsValidDiscId(s string) bool {
+	if len(s) != 28 || strings.ContainsAny(s, "+/=") {
+		return false
+	}
+	encoded := strings.NewReplacer(".", "+", "_", "/", "-", "=").Replace(s)
+	hash, err := base64.StdEncoding.Strict().DecodeString(encoded)
+	return err == nil && len(hash) == 20
+}
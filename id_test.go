@@ -0,0 +1,36 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestIsValidDiscId(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"))
+	assert.True(discid.IsValidDiscId("lSOVc5h6IXSuzcamJS1Gp4_tRuA-"))
+	assert.False(discid.IsValidDiscId(""))
+	assert.False(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U"))
+	assert.False(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U--"))
+	assert.False(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz+Zjs/U="))
+	assert.False(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_UA"))
+	assert.False(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U!"))
+	assert.False(discid.IsValidDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_V-"))
+}
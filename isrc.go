@@ -0,0 +1,151 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "strings"
+
+// Kind of problem found with an ISRC
+type IsrcProblem int
+
+const (
+	// No ISRC was read for the track
+	IsrcEmpty IsrcProblem = iota + 1
+	// The ISRC does not have the format CCXXXYYNNNNN
+	IsrcMalformed
+	// The same ISRC was read for multiple tracks
+	IsrcDuplicate
+	// The ISRC has the same registrant and year as the ISRC of the
+	// previous track, but a lower designation code. Drives sometimes return
+	// the ISRC of a different track.
+	IsrcOutOfSequence
+)
+
+func (p IsrcProblem) String() string {
+	switch p {
+	case IsrcEmpty:
+		return "empty"
+	case IsrcMalformed:
+		return "malformed"
+	case IsrcDuplicate:
+		return "duplicate"
+	case IsrcOutOfSequence:
+		return "out of sequence"
+	default:
+		return "unknown"
+	}
+}
+
+// A problem found with the ISRC of a track
+type IsrcIssue struct {
+	// Track number
+	Track int
+	// The ISRC as read from the disc
+	Isrc    string
+	Problem IsrcProblem
+}
+
+// Check if s is a syntactically valid ISRC.
+//
+// A valid ISRC consists of 12 characters: a two letter country code, a three
+// character alphanumeric registrant code, two digits for the year and a five
+// digit designation code, e.g. "DEA123456789". Dashes are not accepted.
+func IsValidIsrc(s string) bool {
+	if len(s) != 12 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		isLetter := c >= 'A' && c <= 'Z'
+		isDigit := c >= '0' && c <= '9'
+		switch {
+		case i < 2 && !isLetter:
+			return false
+		case i >= 2 && i < 5 && !isLetter && !isDigit:
+			return false
+		case i >= 5 && !isDigit:
+			return false
+		}
+	}
+	return true
+}
+
+// Check the ISRCs of all tracks for problems.
+//
+// Many drives return wrong data for ISRCs, use this to decide whether the
+// ISRCs are trustworthy before e.g. submitting them to MusicBrainz. The disc
+// must have been read with discid.FeatureIsrc, otherwise all ISRCs will be
+// reported as empty. Returns an empty slice if no problems were found.
+func (d Disc) IsrcReport() []IsrcIssue {
+	return isrcReport(d.Snapshot().Tracks)
+}
+
+func isrcReport(tracks []Track) []IsrcIssue {
+	counts := make(map[string]int)
+	for _, track := range tracks {
+		if track.Isrc != "" {
+			counts[track.Isrc]++
+		}
+	}
+	issues := []IsrcIssue{}
+	previous := ""
+	for _, track := range tracks {
+		isrc := track.Isrc
+		report := func(problem IsrcProblem) {
+			issues = append(issues, IsrcIssue{track.Number, isrc, problem})
+		}
+		switch {
+		case isrc == "":
+			report(IsrcEmpty)
+		case !IsValidIsrc(isrc):
+			report(IsrcMalformed)
+		case counts[isrc] > 1:
+			report(IsrcDuplicate)
+		case previous != "" && isrc[:7] == previous[:7] && isrc[7:] < previous[7:]:
+			report(IsrcOutOfSequence)
+		}
+		if IsValidIsrc(isrc) {
+			previous = isrc
+		}
+	}
+	return issues
+}
+
+// Return the ISRCs with bogus values replaced by empty strings, keyed by
+// track number.
+//
+// An ISRC is considered bogus if it is malformed, if all but the country
+// code are zeros or if the same ISRC was read for every track of a disc
+// with multiple tracks.
+func filterIsrcs(tracks []Track) map[int]string {
+	isrcs := make(map[int]string)
+	same := len(tracks) > 1
+	for _, track := range tracks {
+		isrc := track.Isrc
+		if isrc != tracks[0].Isrc {
+			same = false
+		}
+		if !IsValidIsrc(isrc) || strings.Trim(isrc[2:], "0") == "" {
+			isrc = ""
+		}
+		isrcs[track.Number] = isrc
+	}
+	if same {
+		for number := range isrcs {
+			isrcs[number] = ""
+		}
+	}
+	return isrcs
+}
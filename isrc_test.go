@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidIsrc(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsValidIsrc("DEA123456789"))
+	assert.True(IsValidIsrc("USRC17607839"))
+	assert.False(IsValidIsrc(""))
+	assert.False(IsValidIsrc("000000000000"))
+	assert.False(IsValidIsrc("DE-A12-34-56789"))
+	assert.False(IsValidIsrc("dea123456789"))
+	assert.False(IsValidIsrc("DEA12345678X"))
+	assert.False(IsValidIsrc("DEA1234567890"))
+}
+
+func TestIsrcReport(t *testing.T) {
+	tracks := []Track{
+		{Number: 1, Isrc: "DEA123400001"},
+		{Number: 2, Isrc: "DEA123400002"},
+		{Number: 3, Isrc: ""},
+		{Number: 4, Isrc: "000000000000"},
+		{Number: 5, Isrc: "DEA123400009"},
+		{Number: 6, Isrc: "DEA123400009"},
+		{Number: 7, Isrc: "DEA123400003"},
+		{Number: 8, Isrc: "GBA123400001"},
+	}
+	assert.Equal(t, []IsrcIssue{
+		{3, "", IsrcEmpty},
+		{4, "000000000000", IsrcMalformed},
+		{5, "DEA123400009", IsrcDuplicate},
+		{6, "DEA123400009", IsrcDuplicate},
+		{7, "DEA123400003", IsrcOutOfSequence},
+	}, isrcReport(tracks))
+}
+
+func TestIsrcReportNoProblems(t *testing.T) {
+	tracks := []Track{
+		{Number: 1, Isrc: "DEA123400001"},
+		{Number: 2, Isrc: "DEA123400002"},
+	}
+	assert.Empty(t, isrcReport(tracks))
+}
+
+func TestFilterIsrcs(t *testing.T) {
+	tracks := []Track{
+		{Number: 1, Isrc: "DEA123400001"},
+		{Number: 2, Isrc: "DE0000000000"},
+		{Number: 3, Isrc: "DEA12340000?"},
+		{Number: 4, Isrc: ""},
+	}
+	assert.Equal(t, map[int]string{
+		1: "DEA123400001",
+		2: "",
+		3: "",
+		4: "",
+	}, filterIsrcs(tracks))
+}
+
+func TestFilterIsrcsSameOnEveryTrack(t *testing.T) {
+	tracks := []Track{
+		{Number: 1, Isrc: "DEA123400001"},
+		{Number: 2, Isrc: "DEA123400001"},
+	}
+	assert.Equal(t, map[int]string{1: "", 2: ""}, filterIsrcs(tracks))
+	assert.Equal(t, map[int]string{1: "DEA123400001"}, filterIsrcs(tracks[:1]))
+}
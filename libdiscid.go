@@ -0,0 +1,216 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build cgo && !discid_stub && !(windows && discid_dll)
+// +build cgo
+// +build !discid_stub
+// +build !windows !discid_dll
+
+package discid
+
+// #cgo LDFLAGS: -ldiscid
+// #include <stdlib.h>
+// #include "discid/discid.h"
+//
+// // Copy all values of the disc with a single cgo call. strs receives the
+// // disc ID, FreeDB ID, TOC string, submission URL and MCN followed by the
+// // ISRC of each track at 5 + track number. ints receives the first and
+// // last track number and the number of sectors followed by the offset and
+// // length of each track at 3 + 2 * track number.
+// static void discid_get_all(DiscId *d, char **strs, int *ints) {
+//	strs[0] = discid_get_id(d);
+//	strs[1] = discid_get_freedb_id(d);
+//	strs[2] = discid_get_toc_string(d);
+//	strs[3] = discid_get_submission_url(d);
+//	strs[4] = discid_get_mcn(d);
+//	int first = discid_get_first_track_num(d);
+//	int last = discid_get_last_track_num(d);
+//	ints[0] = first;
+//	ints[1] = last;
+//	ints[2] = discid_get_sectors(d);
+//	for (int i = first; i <= last && i < 100; i++) {
+//		ints[3 + 2 * i] = discid_get_track_offset(d, i);
+//		ints[4 + 2 * i] = discid_get_track_length(d, i);
+//		strs[5 + i] = discid_get_track_isrc(d, i);
+//	}
+// }
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+// Disc data backed by libdiscid
+//
+// All values are copied from libdiscid once after reading, as each call
+// into C is expensive compared to a Go function call.
+type libdiscidHandle struct {
+	c    *C.DiscId
+	data libdiscidData
+}
+
+// The values of a disc as copied by discid_get_all
+type libdiscidData struct {
+	id            string
+	freedbId      string
+	tocString     string
+	submissionUrl string
+	mcn           string
+	first         int
+	last          int
+	sectors       int
+	offsets       [100]int
+	lengths       [100]int
+	isrcs         [100]string
+}
+
+// Copy all values from libdiscid into h.data.
+func (h *libdiscidHandle) load() {
+	var strs [105]*C.char
+	var ints [203]C.int
+	C.discid_get_all(h.c, &strs[0], &ints[0])
+	h.data = libdiscidData{
+		id:            C.GoString(strs[0]),
+		freedbId:      C.GoString(strs[1]),
+		tocString:     C.GoString(strs[2]),
+		submissionUrl: C.GoString(strs[3]),
+		mcn:           C.GoString(strs[4]),
+		first:         int(ints[0]),
+		last:          int(ints[1]),
+		sectors:       int(ints[2]),
+	}
+	for n := h.data.first; n <= h.data.last && n < 100; n++ {
+		h.data.offsets[n] = int(ints[3+2*n])
+		h.data.lengths[n] = int(ints[4+2*n])
+		h.data.isrcs[n] = C.GoString(strs[5+n])
+	}
+}
+
+func defaultDevice() string {
+	device := C.discid_get_default_device()
+	return C.GoString(device)
+}
+
+func version() string {
+	version := C.discid_get_version_string()
+	return C.GoString(version)
+}
+
+func hasFeature(feature Feature) bool {
+	result := C.discid_has_feature(uint32(feature))
+	return result == 1
+}
+
+func readHandle(device string, features Feature) (handle, error) {
+	h := &libdiscidHandle{c: C.discid_new()}
+	var c_device *C.char = nil
+	if device != "" {
+		c_device = C.CString(device)
+		defer C.free(unsafe.Pointer(c_device))
+	}
+	var status = C.discid_read_sparse(h.c, c_device, C.uint(features))
+	if status == 0 {
+		defer h.free()
+		return nil, errors.New(h.errorMessage())
+	}
+	h.load()
+	return h, nil
+}
+
+func putHandle(first int, last int, offsets *[100]int) (handle, error) {
+	h := &libdiscidHandle{c: C.discid_new()}
+	var c_offsets [100]C.int
+	for i, n := range offsets {
+		c_offsets[i] = C.int(n)
+	}
+	var status = C.discid_put(h.c, C.int(first), C.int(last), &c_offsets[0])
+	if status == 0 {
+		defer h.free()
+		return nil, errors.New(h.errorMessage())
+	}
+	h.load()
+	return h, nil
+}
+
+func (h *libdiscidHandle) free() {
+	C.discid_free(h.c)
+}
+
+func (h *libdiscidHandle) errorMessage() string {
+	err := C.discid_get_error_msg(h.c)
+	return C.GoString(err)
+}
+
+func (h *libdiscidHandle) id() string {
+	return h.data.id
+}
+
+func (h *libdiscidHandle) freedbId() string {
+	return h.data.freedbId
+}
+
+func (h *libdiscidHandle) tocString() string {
+	return h.data.tocString
+}
+
+func (h *libdiscidHandle) submissionUrl() string {
+	return h.data.submissionUrl
+}
+
+func (h *libdiscidHandle) firstTrackNum() int {
+	return h.data.first
+}
+
+func (h *libdiscidHandle) lastTrackNum() int {
+	return h.data.last
+}
+
+func (h *libdiscidHandle) sectors() int {
+	return h.data.sectors
+}
+
+func (h *libdiscidHandle) mcn() string {
+	return h.data.mcn
+}
+
+func (h *libdiscidHandle) trackOffset(number int) int {
+	if number < h.data.first || number > h.data.last {
+		return 0
+	}
+	return h.data.offsets[number]
+}
+
+func (h *libdiscidHandle) trackLength(number int) int {
+	if number < h.data.first || number > h.data.last {
+		return 0
+	}
+	return h.data.lengths[number]
+}
+
+func (h *libdiscidHandle) trackIsrc(number int) string {
+	if number < h.data.first || number > h.data.last {
+		return ""
+	}
+	return h.data.isrcs[number]
+}
+
+// Sub-indexes cannot be read with libdiscid.
+func (h *libdiscidHandle) trackIndexes(number int) []int {
+	return nil
+}
+
+func (h *libdiscidHandle) trackIsData(number int) bool {
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// Read the TOC from the lines of a log or tool output matching pattern.
+//
+// The pattern must contain the named groups "track" and "start" with the
+// track number and the logical block address of the track start as well as
+// either "end" with the address following the last sector of the track or
+// "length" with the track length in sectors. All other lines are ignored.
+func parseTrackLines(r io.Reader, pattern *regexp.Regexp) (disc Disc, err error) {
+	first := 0
+	lbas := []int{}
+	leadout := 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		match := pattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		values := make(map[string]int)
+		for i, name := range pattern.SubexpNames() {
+			if name == "" || match[i] == "" {
+				continue
+			}
+			// The patterns ensure the values are numeric, only overflows can fail
+			value, e := strconv.Atoi(match[i])
+			if e != nil {
+				return disc, fmt.Errorf("invalid track line %q", scanner.Text())
+			}
+			values[name] = value
+		}
+		track := values["track"]
+		if first == 0 {
+			first = track
+		} else if track != first+len(lbas) {
+			return disc, fmt.Errorf("track numbers are not consecutive at track %v", track)
+		}
+		lbas = append(lbas, values["start"])
+		if length, ok := values["length"]; ok {
+			leadout = values["start"] + length
+		} else {
+			leadout = values["end"]
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	return putLbas(first, lbas, leadout)
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The default URL of the AcoustID lookup web service
+const DefaultAcoustIdUrl = "https://api.acoustid.org/v2/lookup"
+
+// The AcoustID fingerprint of a track, as calculated by fpcalc
+type Fingerprint struct {
+	// Position of the track on the medium, starting with 1
+	Position int
+	// Duration of the fingerprinted audio
+	Duration time.Duration
+	// The compressed fingerprint
+	Fingerprint string
+}
+
+// Resolves fingerprints to MusicBrainz recording IDs.
+//
+// AcoustId implements this using the AcoustID web service. Applications can
+// provide their own implementation, e.g. backed by a local database.
+type RecordingResolver interface {
+	// Return the IDs of the MusicBrainz recordings matching the fingerprint.
+	RecordingIds(ctx context.Context, fp Fingerprint) ([]string, error)
+}
+
+// Client for the AcoustID web service (https://acoustid.org/webservice).
+type AcoustId struct {
+	// URL of the lookup endpoint. Defaults to DefaultAcoustIdUrl.
+	BaseUrl string
+	// The application's API key
+	ApiKey string
+	// Results with a score below MinScore are ignored. Defaults to 0.5.
+	MinScore float64
+	// The HTTP client used for requests. If nil http.DefaultClient is used.
+	Client *http.Client
+}
+
+// The result of checking a single track
+type TrackVerification struct {
+	// Position of the track on the medium
+	Position int
+	// The recording ID of the matched track
+	RecordingId string
+	// The recording IDs found for the fingerprint
+	FingerprintRecordingIds []string
+	// True if the fingerprint matched no recording at all, in this case
+	// the track can not be verified
+	Unknown bool
+	// True if the recording of the matched track was found for the
+	// fingerprint
+	Matched bool
+}
+
+// Cross-check the recordings of a matched medium with fingerprints.
+//
+// For each fingerprint the recordings are looked up with the resolver and
+// compared with the recording of the track at the same position on the
+// medium. A mismatch indicates the TOC matched the wrong edition of a
+// release, e.g. a remaster with identical track lengths. Fingerprints
+// without a track at their position are reported as not matched.
+func VerifyRecordings(ctx context.Context, medium Medium, fingerprints []Fingerprint, resolver RecordingResolver) ([]TrackVerification, error) {
+	results := make([]TrackVerification, 0, len(fingerprints))
+	for _, fp := range fingerprints {
+		ids, err := resolver.RecordingIds(ctx, fp)
+		if err != nil {
+			return nil, fmt.Errorf("track %v: %w", fp.Position, err)
+		}
+		result := TrackVerification{
+			Position:                fp.Position,
+			FingerprintRecordingIds: ids,
+			Unknown:                 len(ids) == 0,
+		}
+		for _, track := range medium.Tracks {
+			if track.Position == fp.Position {
+				result.RecordingId = track.Recording.Id
+				break
+			}
+		}
+		for _, id := range ids {
+			if id == result.RecordingId && id != "" {
+				result.Matched = true
+				break
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Return true if no known fingerprint contradicts the medium.
+//
+// Tracks with unknown fingerprints are ignored. Use this to decide if
+// the results of VerifyRecordings confirm the match.
+func RecordingsConfirmed(results []TrackVerification) bool {
+	for _, result := range results {
+		if !result.Unknown && !result.Matched {
+			return false
+		}
+	}
+	return true
+}
+
+// Look up the recordings for the fingerprint, implementing
+// RecordingResolver.
+func (a *AcoustId) RecordingIds(ctx context.Context, fp Fingerprint) ([]string, error) {
+	baseUrl := a.BaseUrl
+	if baseUrl == "" {
+		baseUrl = DefaultAcoustIdUrl
+	}
+	minScore := a.MinScore
+	if minScore == 0 {
+		minScore = 0.5
+	}
+	form := url.Values{}
+	form.Set("client", a.ApiKey)
+	form.Set("meta", "recordingids")
+	form.Set("duration", strconv.Itoa(int(fp.Duration/time.Second)))
+	form.Set("fingerprint", fp.Fingerprint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseUrl,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client := a.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	result := struct {
+		Status string `json:"status"`
+		Error  struct {
+			Message string `json:"message"`
+		} `json:"error"`
+		Results []struct {
+			Score      float64 `json:"score"`
+			Recordings []struct {
+				Id string `json:"id"`
+			} `json:"recordings"`
+		} `json:"results"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("AcoustID request failed with status %v", resp.Status)
+	}
+	if result.Status != "ok" {
+		return nil, fmt.Errorf("AcoustID request failed: %v", result.Error.Message)
+	}
+	ids := []string{}
+	for _, r := range result.Results {
+		if r.Score < minScore {
+			continue
+		}
+		for _, recording := range r.Recordings {
+			ids = append(ids, recording.Id)
+		}
+	}
+	return ids, nil
+}
+
+// Parse the default output of the fpcalc tool.
+//
+// fpcalc prints the lines "DURATION=<seconds>" and "FINGERPRINT=<fp>". The
+// position of the returned fingerprint is not set.
+func ParseFpcalc(r io.Reader) (Fingerprint, error) {
+	var fp Fingerprint
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		parts := strings.SplitN(strings.TrimSpace(scanner.Text()), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "DURATION":
+			seconds, err := strconv.ParseFloat(parts[1], 64)
+			if err != nil {
+				return fp, err
+			}
+			fp.Duration = time.Duration(seconds * float64(time.Second))
+		case "FINGERPRINT":
+			fp.Fingerprint = parts[1]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fp, err
+	}
+	if fp.Fingerprint == "" {
+		return fp, errors.New("no fingerprint found")
+	}
+	return fp, nil
+}
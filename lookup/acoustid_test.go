@@ -0,0 +1,112 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+type staticResolver map[string][]string
+
+func (r staticResolver) RecordingIds(ctx context.Context, fp lookup.Fingerprint) ([]string, error) {
+	return r[fp.Fingerprint], nil
+}
+
+func TestVerifyRecordings(t *testing.T) {
+	assert := assert.New(t)
+	medium := lookup.Medium{Tracks: []lookup.Track{
+		{Position: 1, Recording: lookup.Recording{Id: "r1"}},
+		{Position: 2, Recording: lookup.Recording{Id: "r2"}},
+		{Position: 3, Recording: lookup.Recording{Id: "r3"}},
+	}}
+	resolver := staticResolver{
+		"fp1": {"other", "r1"},
+		"fp2": {"remaster"},
+	}
+	fingerprints := []lookup.Fingerprint{
+		{Position: 1, Fingerprint: "fp1"},
+		{Position: 3, Fingerprint: "fp3"},
+	}
+	results, err := lookup.VerifyRecordings(context.Background(), medium, fingerprints, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(results, 2) {
+		assert.True(results[0].Matched)
+		assert.Equal("r1", results[0].RecordingId)
+		assert.True(results[1].Unknown)
+		assert.False(results[1].Matched)
+	}
+	assert.True(lookup.RecordingsConfirmed(results))
+
+	fingerprints = append(fingerprints, lookup.Fingerprint{Position: 2, Fingerprint: "fp2"})
+	results, err = lookup.VerifyRecordings(context.Background(), medium, fingerprints, resolver)
+	if assert.NoError(err) && assert.Len(results, 3) {
+		assert.False(results[2].Matched)
+		assert.Equal([]string{"remaster"}, results[2].FingerprintRecordingIds)
+		assert.False(lookup.RecordingsConfirmed(results))
+	}
+}
+
+func TestAcoustIdRecordingIds(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("key", r.FormValue("client"))
+		assert.Equal("recordingids", r.FormValue("meta"))
+		assert.Equal("264", r.FormValue("duration"))
+		assert.Equal("AQADtEmUJEk", r.FormValue("fingerprint"))
+		w.Write([]byte(`{"status": "ok", "results": [
+			{"id": "a", "score": 0.95, "recordings": [{"id": "r1"}, {"id": "r2"}]},
+			{"id": "b", "score": 0.2, "recordings": [{"id": "r3"}]}
+		]}`))
+	}))
+	defer server.Close()
+	client := lookup.AcoustId{BaseUrl: server.URL, ApiKey: "key"}
+	fp := lookup.Fingerprint{Duration: 264666 * time.Millisecond, Fingerprint: "AQADtEmUJEk"}
+	ids, err := client.RecordingIds(context.Background(), fp)
+	if assert.NoError(err) {
+		assert.Equal([]string{"r1", "r2"}, ids)
+	}
+}
+
+func TestAcoustIdError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status": "error", "error": {"code": 4, "message": "invalid API key"}}`))
+	}))
+	defer server.Close()
+	client := lookup.AcoustId{BaseUrl: server.URL}
+	_, err := client.RecordingIds(context.Background(), lookup.Fingerprint{})
+	assert.EqualError(t, err, "AcoustID request failed: invalid API key")
+}
+
+func TestParseFpcalc(t *testing.T) {
+	fp, err := lookup.ParseFpcalc(strings.NewReader("FILE=track01.flac\nDURATION=264.67\nFINGERPRINT=AQADtEmUJEk\n"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "AQADtEmUJEk", fp.Fingerprint)
+		assert.Equal(t, 264670*time.Millisecond, fp.Duration.Round(time.Millisecond))
+	}
+	_, err = lookup.ParseFpcalc(strings.NewReader("DURATION=264\n"))
+	assert.Error(t, err)
+}
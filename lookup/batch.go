@@ -0,0 +1,182 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// The default interval between requests of a BatchLookup, matching the
+// MusicBrainz rate limit of one request per second.
+const DefaultBatchInterval = time.Second
+
+// Resolves many disc IDs to MusicBrainz releases.
+//
+// Requests are sent one after another, waiting Interval between them. Each
+// result is written to Output as a line of JSON as soon as it is available.
+// If a batch gets interrupted the results read back with ReadBatchResults
+// can be passed to Run to continue where it stopped.
+type BatchLookup struct {
+	// The client used for the lookups, must not be nil
+	MusicBrainz *MusicBrainz
+	// Minimum time between two requests. Defaults to DefaultBatchInterval.
+	Interval time.Duration
+	// Called after each looked up disc ID, might be nil
+	Progress func(progress BatchProgress)
+	// If set each result is written as a line of JSON
+	Output io.Writer
+}
+
+// The result of looking up a single disc ID in a batch
+type BatchResult struct {
+	DiscId   string    `json:"disc_id"`
+	Releases []Release `json:"releases,omitempty"`
+	// True if the disc ID is unknown to MusicBrainz
+	NotFound bool `json:"not_found,omitempty"`
+	// The error message if the lookup failed, e.g. because of network
+	// errors. Failed lookups are retried when resuming a batch.
+	Error string `json:"error,omitempty"`
+}
+
+// Returns true if the lookup finished, either with releases or with the
+// disc ID not being found.
+func (r BatchResult) Done() bool {
+	return r.Error == ""
+}
+
+// Progress of a running batch
+type BatchProgress struct {
+	// The number of disc IDs looked up, including those already done in
+	// previous runs
+	Done int
+	// Total number of distinct disc IDs in the batch
+	Total int
+	// The result of the last lookup
+	Result BatchResult
+}
+
+// Look up all disc IDs.
+//
+// The results of an earlier, interrupted run can be passed as completed.
+// Disc IDs with a finished result are skipped, failed lookups are retried.
+// Duplicate disc IDs are looked up only once. The returned results contain
+// the completed results followed by the new ones, one per distinct disc ID.
+//
+// Failed lookups do not stop the batch, they are reported in the result.
+// If ctx gets cancelled Run returns the results so far together with the
+// context's error.
+func (b *BatchLookup) Run(ctx context.Context, discIds []string, completed []BatchResult) ([]BatchResult, error) {
+	interval := b.Interval
+	if interval == 0 {
+		interval = DefaultBatchInterval
+	}
+	results := []BatchResult{}
+	seen := make(map[string]bool)
+	for _, result := range completed {
+		if result.Done() && !seen[result.DiscId] {
+			seen[result.DiscId] = true
+			results = append(results, result)
+		}
+	}
+	pending := []string{}
+	for _, id := range discIds {
+		if !seen[id] {
+			seen[id] = true
+			pending = append(pending, id)
+		}
+	}
+
+	total := len(results) + len(pending)
+	var encoder *json.Encoder
+	if b.Output != nil {
+		encoder = json.NewEncoder(b.Output)
+	}
+	for i, id := range pending {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return results, ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+		result := b.lookup(ctx, id)
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		results = append(results, result)
+		if encoder != nil {
+			if err := encoder.Encode(result); err != nil {
+				return results, err
+			}
+		}
+		if b.Progress != nil {
+			b.Progress(BatchProgress{Done: len(results), Total: total, Result: result})
+		}
+	}
+	return results, nil
+}
+
+func (b *BatchLookup) lookup(ctx context.Context, id string) BatchResult {
+	result := BatchResult{DiscId: id}
+	releases, err := b.MusicBrainz.LookupDiscId(ctx, id)
+	switch {
+	case err == ErrNotFound:
+		result.NotFound = true
+	case err != nil:
+		result.Error = err.Error()
+	default:
+		result.Releases = releases
+	}
+	return result
+}
+
+// Read the results written to BatchLookup.Output.
+//
+// If the same disc ID occurs multiple times the last result wins. An
+// incomplete last line, e.g. from an interrupted write, is ignored.
+func ReadBatchResults(r io.Reader) ([]BatchResult, error) {
+	results := []BatchResult{}
+	positions := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var pendingErr error
+	for scanner.Scan() {
+		if pendingErr != nil {
+			return nil, pendingErr
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var result BatchResult
+		if err := json.Unmarshal(line, &result); err != nil {
+			// Only fail if this is not the last line
+			pendingErr = err
+			continue
+		}
+		if i, ok := positions[result.DiscId]; ok {
+			results[i] = result
+		} else {
+			positions[result.DiscId] = len(results)
+			results = append(results, result)
+		}
+	}
+	return results, scanner.Err()
+}
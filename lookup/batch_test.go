@@ -0,0 +1,122 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBatchServer(requests *[]string) (*lookup.MusicBrainz, *httptest.Server) {
+	return newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/discid/")
+		*requests = append(*requests, id)
+		switch id {
+		case "found":
+			w.Write([]byte(`{"releases": [{"id": "abc", "title": "Found"}]}`))
+		case "broken":
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestBatchLookup(t *testing.T) {
+	assert := assert.New(t)
+	requests := []string{}
+	var output bytes.Buffer
+	progress := []lookup.BatchProgress{}
+	mb, server := newBatchServer(&requests)
+	defer server.Close()
+	batch := lookup.BatchLookup{
+		MusicBrainz: mb,
+		Interval:    time.Millisecond,
+		Output:      &output,
+		Progress:    func(p lookup.BatchProgress) { progress = append(progress, p) },
+	}
+	results, err := batch.Run(context.Background(),
+		[]string{"found", "unknown", "found", "broken"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"found", "unknown", "broken"}, requests)
+	if assert.Len(results, 3) {
+		assert.Equal("Found", results[0].Releases[0].Title)
+		assert.True(results[1].NotFound)
+		assert.True(results[1].Done())
+		assert.False(results[2].Done())
+	}
+	if assert.Len(progress, 3) {
+		assert.Equal(3, progress[2].Done)
+		assert.Equal(3, progress[2].Total)
+		assert.Equal("broken", progress[2].Result.DiscId)
+	}
+
+	// Resume from the written results, only the failed lookup is retried
+	completed, err := lookup.ReadBatchResults(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(results, completed)
+	requests = requests[:0]
+	batch.Output = nil
+	batch.Progress = nil
+	results, err = batch.Run(context.Background(),
+		[]string{"found", "unknown", "broken"}, completed)
+	if assert.NoError(err) {
+		assert.Equal([]string{"broken"}, requests)
+		assert.Len(results, 3)
+	}
+}
+
+func TestBatchLookupCancel(t *testing.T) {
+	requests := []string{}
+	ctx, cancel := context.WithCancel(context.Background())
+	mb, server := newBatchServer(&requests)
+	defer server.Close()
+	batch := lookup.BatchLookup{
+		MusicBrainz: mb,
+		Interval:    time.Hour,
+		Progress:    func(p lookup.BatchProgress) { cancel() },
+	}
+	results, err := batch.Run(ctx, []string{"found", "unknown"}, nil)
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, results, 1)
+}
+
+func TestReadBatchResults(t *testing.T) {
+	input := `{"disc_id": "a", "error": "timeout"}
+{"disc_id": "b", "not_found": true}
+{"disc_id": "a", "releases": [{"id": "abc"}]}
+{"disc_id": "c", "rel`
+	results, err := lookup.ReadBatchResults(strings.NewReader(input))
+	if assert.NoError(t, err) && assert.Len(t, results, 2) {
+		assert.Equal(t, "abc", results[0].Releases[0].Id)
+		assert.True(t, results[1].NotFound)
+	}
+
+	_, err = lookup.ReadBatchResults(strings.NewReader("{\n{\"disc_id\": \"a\"}\n"))
+	assert.Error(t, err)
+}
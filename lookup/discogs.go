@@ -0,0 +1,142 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	discid "github.com/phw/go-discid"
+)
+
+// The default base URL of the Discogs API
+const DefaultDiscogsUrl = "https://api.discogs.com/"
+
+// Client for the Discogs API (https://www.discogs.com/developers).
+//
+// Discogs has no disc IDs, discs are searched by their MCN as barcode. Use
+// it after MusicBrainz, e.g. with IdentifySnapshot, to find releases only
+// listed on Discogs. The Discogs search requires authentication, hence a
+// personal access token must be set.
+type Discogs struct {
+	// Base URL of the API. Defaults to DefaultDiscogsUrl.
+	BaseUrl string
+	// Personal access token, see https://www.discogs.com/settings/developers
+	Token string
+	// User agent identifying the application, required by Discogs.
+	UserAgent string
+	// The HTTP client used for requests. If nil http.DefaultClient is used.
+	Client *http.Client
+}
+
+// A release found by the Discogs search
+type DiscogsRelease struct {
+	Id int `json:"id"`
+	// Artist and title, separated by " - "
+	Title   string   `json:"title"`
+	Year    string   `json:"year"`
+	Country string   `json:"country"`
+	Labels  []string `json:"label"`
+	CatNo   string   `json:"catno"`
+	Formats []string `json:"format"`
+	Barcode []string `json:"barcode"`
+	// Path of the release page, relative to https://www.discogs.com
+	Uri string `json:"uri"`
+}
+
+// Search for releases with the given barcode.
+//
+// Returns ErrNotFound if no release was found.
+func (d *Discogs) SearchBarcode(ctx context.Context, barcode string) ([]DiscogsRelease, error) {
+	query := url.Values{}
+	query.Set("type", "release")
+	query.Set("barcode", barcode)
+	u := strings.TrimSuffix(d.baseUrl(), "/") + "/database/search?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if d.UserAgent != "" {
+		req.Header.Set("User-Agent", d.UserAgent)
+	}
+	if d.Token != "" {
+		req.Header.Set("Authorization", "Discogs token="+d.Token)
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Discogs request failed with status %v", resp.Status)
+	}
+	result := struct {
+		Results []DiscogsRelease `json:"results"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, ErrNotFound
+	}
+	return result.Results, nil
+}
+
+// Look up the disc by its MCN, implementing the Lookup interface.
+//
+// Matches by barcode are never exact and do not include tracks. Returns
+// ErrNotFound without sending a request if the disc has no valid MCN.
+func (d *Discogs) Lookup(ctx context.Context, disc discid.Snapshot) ([]ReleaseMatch, error) {
+	if !discid.IsValidMcn(disc.Mcn) {
+		return nil, ErrNotFound
+	}
+	releases, err := d.SearchBarcode(ctx, disc.Mcn)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]ReleaseMatch, 0, len(releases))
+	for _, release := range releases {
+		match := ReleaseMatch{
+			Source: "discogs",
+			Id:     strconv.Itoa(release.Id),
+			Title:  release.Title,
+			Date:   release.Year,
+		}
+		if i := strings.Index(release.Title, " - "); i >= 0 {
+			match.Artist = release.Title[:i]
+			match.Title = release.Title[i+3:]
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+func (d *Discogs) baseUrl() string {
+	if d.BaseUrl == "" {
+		return DefaultDiscogsUrl
+	}
+	return d.BaseUrl
+}
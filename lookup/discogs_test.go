@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDiscogsServer(handler http.HandlerFunc) (*lookup.Discogs, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	return &lookup.Discogs{BaseUrl: server.URL, Token: "secret", UserAgent: "go-discid-test/1.0"}, server
+}
+
+func TestDiscogsLookup(t *testing.T) {
+	assert := assert.New(t)
+	discogs, server := newDiscogsServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/database/search", r.URL.Path)
+		assert.Equal("4006381333931", r.URL.Query().Get("barcode"))
+		assert.Equal("release", r.URL.Query().Get("type"))
+		assert.Equal("Discogs token=secret", r.Header.Get("Authorization"))
+		assert.Equal("go-discid-test/1.0", r.Header.Get("User-Agent"))
+		w.Write([]byte(`{"results": [{"id": 1234, "title": "Foo - Test Album", "year": "1999",
+			"country": "Germany", "label": ["Label"], "catno": "CAT 1", "format": ["CD", "Album"],
+			"barcode": ["4006381333931"], "uri": "/Foo-Test-Album/release/1234"}]}`))
+	})
+	defer server.Close()
+	disc := discid.Snapshot{Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-", Mcn: "4006381333931"}
+	matches, err := discogs.Lookup(context.Background(), disc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]lookup.ReleaseMatch{{
+		Source: "discogs",
+		Id:     "1234",
+		Title:  "Test Album",
+		Artist: "Foo",
+		Date:   "1999",
+	}}, matches)
+}
+
+func TestDiscogsLookupNotFound(t *testing.T) {
+	discogs, server := newDiscogsServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+	disc := discid.Snapshot{Mcn: "4006381333931"}
+	_, err := discogs.Lookup(context.Background(), disc)
+	assert.Equal(t, lookup.ErrNotFound, err)
+}
+
+func TestDiscogsLookupWithoutMcn(t *testing.T) {
+	discogs, server := newDiscogsServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+	defer server.Close()
+	_, err := discogs.Lookup(context.Background(), discid.Snapshot{})
+	assert.Equal(t, lookup.ErrNotFound, err)
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	discid "github.com/phw/go-discid"
+)
+
+// A release in a DumpIndex
+type DumpRelease struct {
+	// The MusicBrainz release ID (MBID)
+	Id    string
+	Title string
+}
+
+// Local index mapping disc IDs to MusicBrainz releases.
+//
+// The index is built from the MusicBrainz database dumps with
+// ImportMusicBrainzDump and allows identifying discs without network
+// access, e.g. in air-gapped archival environments. It can be saved with
+// DumpIndex.Save and loaded again with LoadDumpIndex.
+type DumpIndex struct {
+	releases map[string][]DumpRelease
+}
+
+// Build a DumpIndex from the tables of a MusicBrainz database dump.
+//
+// dir must contain the files cdtoc, medium_cdtoc, medium and release as
+// found in the mbdump directory of the extracted mbdump.tar.bz2 archive
+// (https://musicbrainz.org/doc/MusicBrainz_Database/Download).
+func ImportMusicBrainzDump(dir string) (*DumpIndex, error) {
+	// cdtoc: id, discid, ...
+	discIds := make(map[string]string)
+	err := readDumpTable(dir, "cdtoc", 2, func(fields []string) {
+		discIds[fields[0]] = fields[1]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// medium_cdtoc: id, medium, cdtoc, ...
+	mediumDiscIds := make(map[string][]string)
+	err = readDumpTable(dir, "medium_cdtoc", 3, func(fields []string) {
+		if id, ok := discIds[fields[2]]; ok {
+			mediumDiscIds[fields[1]] = append(mediumDiscIds[fields[1]], id)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// medium: id, release, ...
+	releaseDiscIds := make(map[string][]string)
+	err = readDumpTable(dir, "medium", 2, func(fields []string) {
+		if ids, ok := mediumDiscIds[fields[0]]; ok {
+			releaseDiscIds[fields[1]] = append(releaseDiscIds[fields[1]], ids...)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// release: id, gid, name, ...
+	index := &DumpIndex{releases: make(map[string][]DumpRelease)}
+	err = readDumpTable(dir, "release", 3, func(fields []string) {
+		release := DumpRelease{Id: fields[1], Title: unescapeDumpField(fields[2])}
+		for _, id := range releaseDiscIds[fields[0]] {
+			index.add(id, release)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	index.sort()
+	return index, nil
+}
+
+// Load an index previously written with DumpIndex.Save.
+func LoadDumpIndex(r io.Reader) (*DumpIndex, error) {
+	index := &DumpIndex{releases: make(map[string][]DumpRelease)}
+	err := scanDumpLines(r, 3, func(fields []string) {
+		index.add(fields[0], DumpRelease{Id: fields[1], Title: unescapeDumpField(fields[2])})
+	})
+	if err != nil {
+		return nil, err
+	}
+	index.sort()
+	return index, nil
+}
+
+// Write the index to w.
+//
+// The index is written as tab separated lines of disc ID, release MBID and
+// release title, ordered by disc ID.
+func (idx *DumpIndex) Save(w io.Writer) error {
+	ids := make([]string, 0, len(idx.releases))
+	for id := range idx.releases {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	bw := bufio.NewWriter(w)
+	for _, id := range ids {
+		for _, release := range idx.releases[id] {
+			_, err := fmt.Fprintf(bw, "%s\t%s\t%s\n", id, release.Id, escapeDumpField(release.Title))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// The number of disc IDs in the index.
+func (idx *DumpIndex) Len() int {
+	return len(idx.releases)
+}
+
+// Return the releases with the given disc ID, ordered by MBID.
+func (idx *DumpIndex) Releases(discId string) []DumpRelease {
+	return idx.releases[discId]
+}
+
+// Look up the releases for a disc in the index, implementing the Lookup
+// interface.
+//
+// All matches are exact. The dump tables do not contain artists and track
+// listings, only release ID and title are set. Returns ErrNotFound if the
+// disc ID is not in the index.
+func (idx *DumpIndex) Lookup(ctx context.Context, disc discid.Snapshot) ([]ReleaseMatch, error) {
+	releases := idx.Releases(disc.Id)
+	if len(releases) == 0 {
+		return nil, ErrNotFound
+	}
+	matches := make([]ReleaseMatch, 0, len(releases))
+	for _, release := range releases {
+		matches = append(matches, ReleaseMatch{
+			Source: "musicbrainz-dump",
+			Id:     release.Id,
+			Title:  release.Title,
+			Exact:  true,
+		})
+	}
+	return matches, nil
+}
+
+func (idx *DumpIndex) add(discId string, release DumpRelease) {
+	for _, r := range idx.releases[discId] {
+		if r.Id == release.Id {
+			return
+		}
+	}
+	idx.releases[discId] = append(idx.releases[discId], release)
+}
+
+func (idx *DumpIndex) sort() {
+	for _, releases := range idx.releases {
+		sort.Slice(releases, func(i, j int) bool {
+			return releases[i].Id < releases[j].Id
+		})
+	}
+}
+
+func readDumpTable(dir string, table string, minFields int, handle func(fields []string)) error {
+	f, err := os.Open(filepath.Join(dir, table))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := scanDumpLines(f, minFields, handle); err != nil {
+		return fmt.Errorf("%v: %w", table, err)
+	}
+	return nil
+}
+
+// Read lines of tab separated fields in the PostgreSQL COPY text format.
+func scanDumpLines(r io.Reader, minFields int, handle func(fields []string)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < minFields {
+			return fmt.Errorf("line %v: expected at least %v fields, got %v",
+				lineNum, minFields, len(fields))
+		}
+		handle(fields)
+	}
+	return scanner.Err()
+}
+
+var dumpUnescaper = strings.NewReplacer(`\\`, `\`, `\t`, "\t", `\n`, "\n", `\r`, "\r")
+var dumpEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// Decode the backslash escapes of the PostgreSQL COPY text format.
+func unescapeDumpField(s string) string {
+	if s == `\N` {
+		return ""
+	}
+	return dumpUnescaper.Replace(s)
+}
+
+func escapeDumpField(s string) string {
+	return dumpEscaper.Replace(s)
+}
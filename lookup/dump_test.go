@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportMusicBrainzDump(t *testing.T) {
+	assert := assert.New(t)
+	index, err := lookup.ImportMusicBrainzDump("testdata/mbdump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2, index.Len())
+	assert.Equal([]lookup.DumpRelease{
+		{Id: "0a5b0c1d-0000-4000-8000-000000000001", Title: "Test Album (Remaster)\tDeluxe"},
+		{Id: "b6a8e8b4-0000-4000-8000-000000000002", Title: "Test Album"},
+	}, index.Releases("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"))
+	assert.Len(index.Releases("lwHl8fGzJyLXQR33ug60E8jhf4k-"), 1)
+	assert.Nil(index.Releases("unknown"))
+}
+
+func TestImportMusicBrainzDumpMissingTable(t *testing.T) {
+	_, err := lookup.ImportMusicBrainzDump("testdata")
+	assert.Error(t, err)
+}
+
+func TestDumpIndexSaveLoad(t *testing.T) {
+	index, err := lookup.ImportMusicBrainzDump("testdata/mbdump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := index.Save(&b); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, strings.HasPrefix(b.String(),
+		"Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-\t0a5b0c1d-0000-4000-8000-000000000001\tTest Album (Remaster)\\tDeluxe\n"))
+	loaded, err := lookup.LoadDumpIndex(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, index, loaded)
+}
+
+func TestDumpIndexLookup(t *testing.T) {
+	assert := assert.New(t)
+	index, err := lookup.ImportMusicBrainzDump("testdata/mbdump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	disc := discid.Snapshot{Id: "lwHl8fGzJyLXQR33ug60E8jhf4k-"}
+	matches, err := index.Lookup(context.Background(), disc)
+	if assert.NoError(err) && assert.Len(matches, 1) {
+		assert.Equal(lookup.ReleaseMatch{
+			Source: "musicbrainz-dump",
+			Id:     "c7b1a2e3-0000-4000-8000-000000000003",
+			Title:  "Other Album",
+			Exact:  true,
+		}, matches[0])
+	}
+	disc.Id = "unknown"
+	_, err = index.Lookup(context.Background(), disc)
+	assert.Equal(lookup.ErrNotFound, err)
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	discid "github.com/phw/go-discid"
+)
+
+// The default URL of the gnudb CDDB HTTP interface
+const DefaultGnudbUrl = "https://gnudb.gnudb.org/~cddb/cddb.cgi"
+
+// Client for gnudb (https://gnudb.org), a FreeDB compatible CDDB server.
+//
+// Discs are looked up by FreeDB ID using the CDDB protocol over HTTP.
+type Gnudb struct {
+	// URL of the CDDB HTTP interface. Defaults to DefaultGnudbUrl.
+	BaseUrl string
+	// The user name sent in the CDDB hello command. gnudb requires a valid
+	// email address.
+	User string
+	// Name and version of the application sent in the CDDB hello command
+	ClientName    string
+	ClientVersion string
+	// The HTTP client used for requests. If nil http.DefaultClient is used.
+	Client *http.Client
+}
+
+// A CDDB entry as returned by the cddb query command
+type cddbMatch struct {
+	category string
+	discId   string
+	title    string
+}
+
+// Look up the disc by its FreeDB ID, implementing the Lookup interface.
+//
+// All exact and inexact matches are read. gnudb returns inexact matches for
+// discs with a similar TOC, those are not reported as exact. Returns
+// ErrNotFound if there is no match.
+func (g *Gnudb) Lookup(ctx context.Context, disc discid.Snapshot) ([]ReleaseMatch, error) {
+	entries, exact, err := g.query(ctx, disc)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]ReleaseMatch, 0, len(entries))
+	for _, entry := range entries {
+		match, err := g.read(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		match.Exact = exact
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+// Send the cddb query command and return the matching entries.
+func (g *Gnudb) query(ctx context.Context, disc discid.Snapshot) ([]cddbMatch, bool, error) {
+	lines, err := g.command(ctx, "cddb query "+disc.CdDiscidString())
+	if err != nil {
+		return nil, false, err
+	}
+	code, status := splitCddbStatus(lines[0])
+	switch code {
+	case 200:
+		// A single exact match in the status line
+		return []cddbMatch{parseCddbMatch(status)}, true, nil
+	case 202:
+		return nil, false, ErrNotFound
+	case 210, 211:
+		matches := []cddbMatch{}
+		for _, line := range lines[1:] {
+			matches = append(matches, parseCddbMatch(line))
+		}
+		if len(matches) == 0 {
+			return nil, false, ErrNotFound
+		}
+		return matches, code == 210, nil
+	default:
+		return nil, false, fmt.Errorf("gnudb query failed: %v", lines[0])
+	}
+}
+
+// Read the xmcd entry for a query match.
+func (g *Gnudb) read(ctx context.Context, entry cddbMatch) (ReleaseMatch, error) {
+	lines, err := g.command(ctx, "cddb read "+entry.category+" "+entry.discId)
+	if err != nil {
+		return ReleaseMatch{}, err
+	}
+	if code, _ := splitCddbStatus(lines[0]); code != 210 {
+		return ReleaseMatch{}, fmt.Errorf("gnudb read failed: %v", lines[0])
+	}
+	match := parseXmcd(lines[1:])
+	match.Source = "gnudb"
+	match.Id = entry.category + "/" + entry.discId
+	return match, nil
+}
+
+// Send a CDDB command and return the response lines without the
+// terminating ".".
+func (g *Gnudb) command(ctx context.Context, cmd string) ([]string, error) {
+	baseUrl := g.BaseUrl
+	if baseUrl == "" {
+		baseUrl = DefaultGnudbUrl
+	}
+	query := url.Values{}
+	query.Set("cmd", cmd)
+	query.Set("hello", strings.Join([]string{
+		cddbHelloField(g.User, "anonymous"),
+		"localhost",
+		cddbHelloField(g.ClientName, "go-discid"),
+		cddbHelloField(g.ClientVersion, "1.0"),
+	}, " "))
+	query.Set("proto", "6")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseUrl+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	client := g.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gnudb request failed with status %v", resp.Status)
+	}
+	return readCddbResponse(resp.Body)
+}
+
+// Spaces separate the fields of the hello command, replace them.
+func cddbHelloField(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return strings.Replace(value, " ", "_", -1)
+}
+
+func readCddbResponse(r io.Reader) ([]string, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "." {
+			break
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, errors.New("empty gnudb response")
+	}
+	return lines, nil
+}
+
+// Split a CDDB status line into the numeric code and the remaining text.
+func splitCddbStatus(line string) (int, string) {
+	parts := strings.SplitN(line, " ", 2)
+	code, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, line
+	}
+	if len(parts) == 1 {
+		return code, ""
+	}
+	return code, parts[1]
+}
+
+// Parse a match in the format "category discid Artist / Title".
+func parseCddbMatch(line string) cddbMatch {
+	parts := strings.SplitN(line, " ", 3)
+	for len(parts) < 3 {
+		parts = append(parts, "")
+	}
+	return cddbMatch{category: parts[0], discId: parts[1], title: parts[2]}
+}
+
+// Parse the lines of a xmcd database entry.
+//
+// Values can span multiple lines with the same key, they get concatenated.
+func parseXmcd(lines []string) ReleaseMatch {
+	values := make(map[string]string)
+	for _, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 {
+			values[parts[0]] += parts[1]
+		}
+	}
+
+	match := ReleaseMatch{Date: values["DYEAR"]}
+	match.Artist, match.Title = splitXmcdTitle(values["DTITLE"])
+	for i := 0; ; i++ {
+		title, ok := values["TTITLE"+strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		match.Tracks = append(match.Tracks, TrackMatch{Position: i + 1, Title: title})
+	}
+	return match
+}
+
+// Split a DTITLE value in the format "Artist / Title".
+func splitXmcdTitle(value string) (artist string, title string) {
+	parts := strings.SplitN(value, " / ", 2)
+	if len(parts) == 1 {
+		return "", strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+var gnudbDisc = discid.Snapshot{
+	FreedbId: "830abf0a",
+	Sectors:  206535,
+	Tracks: []discid.Track{
+		{Number: 1, Offset: 150},
+		{Number: 2, Offset: 18901},
+		{Number: 3, Offset: 39738},
+	},
+}
+
+func newGnudbServer(t *testing.T, queryResponse string) (*lookup.Gnudb, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "6", r.URL.Query().Get("proto"))
+		assert.Equal(t, "me@example.com localhost test_client 1.0", r.URL.Query().Get("hello"))
+		cmd := r.URL.Query().Get("cmd")
+		switch {
+		case strings.HasPrefix(cmd, "cddb query "):
+			assert.Equal(t, "cddb query 830abf0a 3 150 18901 39738 2753", cmd)
+			w.Write([]byte(queryResponse))
+		case cmd == "cddb read rock 830abf0a":
+			http.ServeFile(w, r, "testdata/gnudb-read.txt")
+		default:
+			w.Write([]byte("401 rock 830abf0a No such CD entry in database.\r\n"))
+		}
+	}))
+	return &lookup.Gnudb{
+		BaseUrl:       server.URL,
+		User:          "me@example.com",
+		ClientName:    "test client",
+		ClientVersion: "1.0",
+	}, server
+}
+
+func TestGnudbLookupExact(t *testing.T) {
+	assert := assert.New(t)
+	gnudb, server := newGnudbServer(t, "200 rock 830abf0a Foo & Bar / Test Album\r\n")
+	defer server.Close()
+	matches, err := gnudb.Lookup(context.Background(), gnudbDisc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(matches, 1) {
+		return
+	}
+	match := matches[0]
+	assert.Equal("gnudb", match.Source)
+	assert.Equal("rock/830abf0a", match.Id)
+	assert.Equal("Foo & Bar", match.Artist)
+	assert.Equal("Test Album", match.Title)
+	assert.Equal("1995", match.Date)
+	assert.True(match.Exact)
+	if assert.Len(match.Tracks, 3) {
+		assert.Equal(lookup.TrackMatch{Position: 1, Title: "First Track"}, match.Tracks[0])
+		assert.Equal("Second Track with a very long title", match.Tracks[1].Title)
+	}
+}
+
+func TestGnudbLookupInexact(t *testing.T) {
+	gnudb, server := newGnudbServer(t, "211 Found inexact matches, list follows (until terminating `.')\r\n"+
+		"rock 830abf0a Foo & Bar / Test Album\r\n"+
+		".\r\n")
+	defer server.Close()
+	matches, err := gnudb.Lookup(context.Background(), gnudbDisc)
+	if assert.NoError(t, err) && assert.Len(t, matches, 1) {
+		assert.False(t, matches[0].Exact)
+		assert.Equal(t, "Test Album", matches[0].Title)
+	}
+}
+
+func TestGnudbLookupNotFound(t *testing.T) {
+	gnudb, server := newGnudbServer(t, "202 No match found\r\n")
+	defer server.Close()
+	_, err := gnudb.Lookup(context.Background(), gnudbDisc)
+	assert.Equal(t, lookup.ErrNotFound, err)
+}
+
+func TestGnudbLookupError(t *testing.T) {
+	gnudb, server := newGnudbServer(t, "500 Command syntax error\r\n")
+	defer server.Close()
+	_, err := gnudb.Lookup(context.Background(), gnudbDisc)
+	assert.EqualError(t, err, "gnudb query failed: 500 Command syntax error")
+}
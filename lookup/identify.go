@@ -0,0 +1,128 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"context"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Combined physical and descriptive data of an identified disc
+type DiscMetadata struct {
+	// The data read from the disc
+	Disc discid.Snapshot
+	// The best matching release, nil if no release was found. Exact matches
+	// are preferred.
+	Release *ReleaseMatch
+	// All matches found by the metadata source
+	Matches []ReleaseMatch
+	// The tracks of the disc with the titles of the best matching release
+	Tracks []TrackMetadata
+}
+
+// A track of the disc with its matched title
+type TrackMetadata struct {
+	discid.Track
+	// The track title, empty if unknown
+	Title string
+	// The track artist, falls back to the release artist
+	Artist string
+	// Identifiers of the track and its recording in the metadata source of
+	// the release, empty if unknown
+	TrackId     string
+	RecordingId string
+}
+
+// Read the disc in the given device and look it up.
+//
+// This reads the TOC, MCN and ISRCs and queries the given metadata sources
+// with IdentifySnapshot. If no source is given MusicBrainz with default
+// settings is used. If the device is an empty string the default device is
+// used.
+func Identify(ctx context.Context, device string, sources ...Lookup) (DiscMetadata, error) {
+	disc, err := discid.ReadFeatures(device, discid.FeatureAll)
+	if err != nil {
+		return DiscMetadata{}, err
+	}
+	snapshot := disc.Snapshot()
+	disc.Close()
+	return IdentifySnapshot(ctx, snapshot, sources...)
+}
+
+// Look up a disc read earlier in the given metadata sources.
+//
+// The sources are queried in order, the first source with matches is used.
+// If no source is given MusicBrainz with default settings is used. If no
+// source found a match ErrNotFound is returned together with the metadata
+// containing only the physical disc data.
+func IdentifySnapshot(ctx context.Context, disc discid.Snapshot, sources ...Lookup) (DiscMetadata, error) {
+	if len(sources) == 0 {
+		sources = []Lookup{&MusicBrainz{}}
+	}
+	metadata := DiscMetadata{Disc: disc}
+	for _, source := range sources {
+		matches, err := source.Lookup(ctx, disc)
+		if err == ErrNotFound || (err == nil && len(matches) == 0) {
+			continue
+		} else if err != nil {
+			return metadata, err
+		}
+		metadata.Matches = matches
+		metadata.Release = bestMatch(matches)
+		break
+	}
+	metadata.Tracks = trackMetadata(disc.Tracks, metadata.Release)
+	if metadata.Release == nil {
+		return metadata, ErrNotFound
+	}
+	return metadata, nil
+}
+
+// Return the first exact match, or the first match if none is exact.
+func bestMatch(matches []ReleaseMatch) *ReleaseMatch {
+	for i := range matches {
+		if matches[i].Exact {
+			return &matches[i]
+		}
+	}
+	return &matches[0]
+}
+
+// Combine the disc tracks with the tracks of the release. Tracks are
+// matched by their position on the disc.
+func trackMetadata(tracks []discid.Track, release *ReleaseMatch) []TrackMetadata {
+	result := make([]TrackMetadata, len(tracks))
+	for i, track := range tracks {
+		result[i].Track = track
+		if release == nil {
+			continue
+		}
+		result[i].Artist = release.Artist
+		for _, match := range release.Tracks {
+			if match.Position == i+1 {
+				result[i].Title = match.Title
+				result[i].TrackId = match.Id
+				result[i].RecordingId = match.RecordingId
+				if match.Artist != "" {
+					result[i].Artist = match.Artist
+				}
+				break
+			}
+		}
+	}
+	return result
+}
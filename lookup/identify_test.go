@@ -0,0 +1,89 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+type staticLookup struct {
+	matches []lookup.ReleaseMatch
+	err     error
+}
+
+func (l staticLookup) Lookup(ctx context.Context, disc discid.Snapshot) ([]lookup.ReleaseMatch, error) {
+	return l.matches, l.err
+}
+
+var identifyDisc = discid.Snapshot{
+	Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-",
+	Tracks: []discid.Track{
+		{Number: 1, Offset: 150},
+		{Number: 2, Offset: 18901},
+	},
+}
+
+func TestIdentifySnapshot(t *testing.T) {
+	assert := assert.New(t)
+	sources := []lookup.Lookup{
+		staticLookup{err: lookup.ErrNotFound},
+		staticLookup{matches: []lookup.ReleaseMatch{
+			{Id: "fuzzy", Artist: "Other"},
+			{Id: "exact", Artist: "Foo", Exact: true, Tracks: []lookup.TrackMatch{
+				{Position: 1, Title: "One"},
+				{Position: 2, Title: "Two", Artist: "Bar"},
+			}},
+		}},
+	}
+	metadata, err := lookup.IdentifySnapshot(context.Background(), identifyDisc, sources...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(identifyDisc, metadata.Disc)
+	assert.Len(metadata.Matches, 2)
+	if assert.NotNil(metadata.Release) {
+		assert.Equal("exact", metadata.Release.Id)
+	}
+	if assert.Len(metadata.Tracks, 2) {
+		assert.Equal(18901, metadata.Tracks[1].Offset)
+		assert.Equal("One", metadata.Tracks[0].Title)
+		assert.Equal("Foo", metadata.Tracks[0].Artist)
+		assert.Equal("Two", metadata.Tracks[1].Title)
+		assert.Equal("Bar", metadata.Tracks[1].Artist)
+	}
+}
+
+func TestIdentifySnapshotNotFound(t *testing.T) {
+	source := staticLookup{err: lookup.ErrNotFound}
+	metadata, err := lookup.IdentifySnapshot(context.Background(), identifyDisc, source)
+	assert.Equal(t, lookup.ErrNotFound, err)
+	assert.Nil(t, metadata.Release)
+	assert.Equal(t, identifyDisc.Id, metadata.Disc.Id)
+	assert.Len(t, metadata.Tracks, 2)
+}
+
+func TestIdentifySnapshotError(t *testing.T) {
+	failure := errors.New("connection failed")
+	source := staticLookup{err: failure}
+	_, err := lookup.IdentifySnapshot(context.Background(), identifyDisc, source)
+	assert.Equal(t, failure, err)
+}
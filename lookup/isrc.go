@@ -0,0 +1,105 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import discid "github.com/phw/go-discid"
+
+// Result of comparing the ISRC of a track with MusicBrainz
+type IsrcStatus int
+
+const (
+	// The ISRC read from the disc is already stored in MusicBrainz
+	IsrcMatch IsrcStatus = iota + 1
+	// The ISRC read from the disc is not yet stored in MusicBrainz and the
+	// recording has no other ISRCs, it can be submitted
+	IsrcNew
+	// The recording in MusicBrainz has ISRCs, but not the one read from the
+	// disc
+	IsrcConflict
+	// No ISRC was read from the disc
+	IsrcMissing
+)
+
+func (s IsrcStatus) String() string {
+	switch s {
+	case IsrcMatch:
+		return "match"
+	case IsrcNew:
+		return "new"
+	case IsrcConflict:
+		return "conflict"
+	case IsrcMissing:
+		return "missing"
+	default:
+		return "unknown"
+	}
+}
+
+// Comparison of the ISRC of a single track
+type IsrcComparison struct {
+	// Track number on the disc
+	Track int
+	// The ISRC read from the disc
+	DiscIsrc string
+	// The recording the track is linked to in MusicBrainz
+	RecordingId string
+	// The ISRCs of the recording stored in MusicBrainz
+	MusicBrainzIsrcs []string
+	Status           IsrcStatus
+}
+
+// Compare the ISRCs read from the disc with the ISRCs in MusicBrainz.
+//
+// The medium is usually found with Release.MediumWithDiscId after looking up
+// the disc ID. Tracks are matched by their position, hence the medium must
+// have the same number of tracks as the disc. The disc should have been read
+// with discid.FeatureIsrc.
+func CompareIsrcs(disc discid.Snapshot, medium Medium) []IsrcComparison {
+	result := []IsrcComparison{}
+	for i, track := range disc.Tracks {
+		if i >= len(medium.Tracks) {
+			break
+		}
+		recording := medium.Tracks[i].Recording
+		c := IsrcComparison{
+			Track:            track.Number,
+			DiscIsrc:         track.Isrc,
+			RecordingId:      recording.Id,
+			MusicBrainzIsrcs: recording.Isrcs,
+		}
+		switch {
+		case c.DiscIsrc == "":
+			c.Status = IsrcMissing
+		case contains(recording.Isrcs, c.DiscIsrc):
+			c.Status = IsrcMatch
+		case len(recording.Isrcs) == 0:
+			c.Status = IsrcNew
+		default:
+			c.Status = IsrcConflict
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareIsrcs(t *testing.T) {
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/discid.json")
+	})
+	defer server.Close()
+	releases, err := mb.LookupDiscId(context.Background(), "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	disc := discid.Snapshot{
+		Tracks: []discid.Track{
+			{Number: 1, Isrc: "DEA123400001"},
+			{Number: 2, Isrc: "DEA123400002"},
+			{Number: 3, Isrc: "DEA123400003"},
+		},
+	}
+	result := lookup.CompareIsrcs(disc, releases[0].Media[0])
+	assert.Equal(t, []lookup.IsrcComparison{
+		{Track: 1, DiscIsrc: "DEA123400001", RecordingId: "r1",
+			MusicBrainzIsrcs: []string{"DEA123400001"}, Status: lookup.IsrcMatch},
+		{Track: 2, DiscIsrc: "DEA123400002", RecordingId: "r2",
+			MusicBrainzIsrcs: []string{}, Status: lookup.IsrcNew},
+		{Track: 3, DiscIsrc: "DEA123400003", RecordingId: "r3",
+			MusicBrainzIsrcs: []string{"DEA123400099"}, Status: lookup.IsrcConflict},
+	}, result)
+}
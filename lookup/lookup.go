@@ -0,0 +1,74 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"context"
+	"time"
+
+	discid "github.com/phw/go-discid"
+)
+
+// A metadata source for identifying discs.
+//
+// Implementations are provided for MusicBrainz, gnudb, Discogs and offline
+// lookups using a DumpIndex built from the MusicBrainz database dumps.
+// Applications can provide their own implementations or combine multiple
+// sources.
+type Lookup interface {
+	// Look up the releases matching the disc. Returns ErrNotFound if there
+	// is no match.
+	Lookup(ctx context.Context, disc discid.Snapshot) ([]ReleaseMatch, error)
+}
+
+// A release matching a looked up disc
+type ReleaseMatch struct {
+	// Name of the metadata source, e.g. "musicbrainz" or "gnudb"
+	Source string
+	// Identifier of the release in the metadata source
+	Id     string
+	Title  string
+	Artist string
+	// Release date or year, might be empty
+	Date string
+	// The tracks of the matched medium, might be empty
+	Tracks []TrackMatch
+	// True if the release matched by disc ID, false for fuzzy matches,
+	// e.g. by barcode
+	Exact bool
+}
+
+// A track of a matched release
+type TrackMatch struct {
+	// Position of the track on the medium, starting with 1
+	Position int
+	Title    string
+	// The track artist, might be empty if identical to the release artist
+	Artist string
+	Length time.Duration
+	// Identifiers of the track and its recording in the metadata source,
+	// might be empty
+	Id          string
+	RecordingId string
+}
+
+// Check the interfaces are implemented.
+var (
+	_ Lookup = &MusicBrainz{}
+	_ Lookup = &Gnudb{}
+	_ Lookup = &DumpIndex{}
+	_ Lookup = &Discogs{}
+)
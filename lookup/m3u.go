@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"io"
+	"strconv"
+
+	discid "github.com/phw/go-discid"
+)
+
+// Write an extended M3U playlist (.m3u8) for the audio tracks of the disc
+// with the titles of the matched release.
+//
+// The titles have the form "Artist - Title". Tracks without title get the
+// placeholder of discid.Snapshot.WriteM3u. fileName returns the file name
+// of a track, if nil the default of discid.M3uOptions is used.
+func (m DiscMetadata) WriteM3u(w io.Writer, fileName func(track discid.Track) string) error {
+	titles := make(map[int]string)
+	for _, track := range m.Tracks {
+		switch {
+		case track.Title == "":
+		case track.Artist == "":
+			titles[track.Number] = track.Title
+		default:
+			titles[track.Number] = track.Artist + " - " + track.Title
+		}
+	}
+	opts := discid.M3uOptions{FileName: fileName}
+	if len(titles) > 0 {
+		opts.Title = func(track discid.Track) string {
+			if title, ok := titles[track.Number]; ok {
+				return title
+			}
+			return "Track " + strconv.Itoa(track.Number)
+		}
+	}
+	return m.Disc.WriteM3u(w, opts)
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"strings"
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscMetadataWriteM3u(t *testing.T) {
+	metadata := lookup.DiscMetadata{
+		Disc: discid.Snapshot{Tracks: []discid.Track{
+			{Number: 1, Sectors: 750},
+			{Number: 2, Sectors: 1500},
+			{Number: 3, Sectors: 2250},
+		}},
+		Tracks: []lookup.TrackMetadata{
+			{Track: discid.Track{Number: 1}, Title: "One", Artist: "Foo"},
+			{Track: discid.Track{Number: 2}, Title: "Two"},
+		},
+	}
+	var b strings.Builder
+	err := metadata.WriteM3u(&b, func(track discid.Track) string {
+		return strings.Repeat("x", track.Number) + ".flac"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "#EXTM3U\n"+
+		"#EXTINF:10,Foo - One\nx.flac\n"+
+		"#EXTINF:20,Two\nxx.flac\n"+
+		"#EXTINF:30,Track 3\nxxx.flac\n", b.String())
+}
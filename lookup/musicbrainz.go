@@ -0,0 +1,278 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// lookup identifies discs using online metadata services.
+//
+// The MusicBrainz client looks up releases by disc ID using the MusicBrainz
+// web service (https://musicbrainz.org/doc/MusicBrainz_API). Please note the
+// MusicBrainz rate limiting rules and always set a meaningful user agent.
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	discid "github.com/phw/go-discid"
+)
+
+// The default base URL of the MusicBrainz web service
+const DefaultMusicBrainzUrl = "https://musicbrainz.org/ws/2/"
+
+// The user agent sent to MusicBrainz if none is set. MusicBrainz requires a
+// meaningful user agent, applications should set their own.
+const DefaultUserAgent = "go-discid ( https://git.sr.ht/~phw/go-discid )"
+
+// Returned if a lookup found no matches.
+var ErrNotFound = errors.New("not found")
+
+// Client for the MusicBrainz web service.
+type MusicBrainz struct {
+	// Base URL of the web service. Defaults to DefaultMusicBrainzUrl.
+	BaseUrl string
+	// User agent identifying the application, e.g.
+	// "MyTagger/1.0 ( me@example.com )". Defaults to DefaultUserAgent.
+	UserAgent string
+	// The HTTP client used for requests. If nil http.DefaultClient is used.
+	Client *http.Client
+	// Authentication for requests requiring authorization, might be nil.
+	// If set all requests are sent with the access token.
+	Auth *OAuth
+}
+
+// A MusicBrainz release
+type Release struct {
+	Id           string         `json:"id"`
+	Title        string         `json:"title"`
+	ArtistCredit []ArtistCredit `json:"artist-credit"`
+	Date         string         `json:"date"`
+	Country      string         `json:"country"`
+	Barcode      string         `json:"barcode"`
+	Status       string         `json:"status"`
+	LabelInfo    []LabelInfo    `json:"label-info"`
+	Media        []Medium       `json:"media"`
+}
+
+// Label and catalog number of a release
+type LabelInfo struct {
+	CatalogNumber string `json:"catalog-number"`
+	Label         struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"label"`
+}
+
+// Credited name of an artist
+type ArtistCredit struct {
+	Name       string `json:"name"`
+	JoinPhrase string `json:"joinphrase"`
+	Artist     struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"artist"`
+}
+
+// A medium of a release
+type Medium struct {
+	Position int     `json:"position"`
+	Format   string  `json:"format"`
+	Title    string  `json:"title"`
+	Discs    []Disc  `json:"discs"`
+	Tracks   []Track `json:"tracks"`
+}
+
+// A disc ID attached to a medium, including its TOC
+type Disc = discid.MBDisc
+
+// A track on a medium
+type Track struct {
+	Id        string    `json:"id"`
+	Number    string    `json:"number"`
+	Position  int       `json:"position"`
+	Title     string    `json:"title"`
+	Length    int       `json:"length"`
+	Recording Recording `json:"recording"`
+}
+
+// The recording of a track
+type Recording struct {
+	Id     string   `json:"id"`
+	Title  string   `json:"title"`
+	Length int      `json:"length"`
+	Isrcs  []string `json:"isrcs"`
+}
+
+// Return the full artist name as credited on the release.
+func (r Release) Artist() string {
+	var b strings.Builder
+	for _, credit := range r.ArtistCredit {
+		b.WriteString(credit.Name)
+		b.WriteString(credit.JoinPhrase)
+	}
+	return b.String()
+}
+
+// Return the medium the given disc ID is attached to, or nil.
+func (r Release) MediumWithDiscId(id string) *Medium {
+	for i, medium := range r.Media {
+		for _, disc := range medium.Discs {
+			if disc.Id == id {
+				return &r.Media[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Look up all releases the disc ID is attached to.
+//
+// The releases include the tracks with their recordings and ISRCs and the
+// labels. Returns ErrNotFound if the disc ID is unknown to MusicBrainz.
+func (m *MusicBrainz) LookupDiscId(ctx context.Context, id string) ([]Release, error) {
+	query := url.Values{}
+	query.Set("inc", "recordings isrcs artist-credits labels")
+	result := struct {
+		Releases []Release `json:"releases"`
+	}{}
+	err := m.get(ctx, "discid/"+url.PathEscape(id), query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Releases) == 0 {
+		return nil, ErrNotFound
+	}
+	return result.Releases, nil
+}
+
+// Search for releases with the given barcode.
+//
+// barcode is usually the MCN read from the disc. As MusicBrainz stores UPCs
+// with 12 digits, an EAN with a leading zero is also searched without it.
+// The found releases do not include track listings. Returns ErrNotFound if
+// no release was found.
+func (m *MusicBrainz) SearchBarcode(ctx context.Context, barcode string) ([]Release, error) {
+	q := "barcode:" + barcode
+	if len(barcode) == 13 && strings.HasPrefix(barcode, "0") {
+		q += " OR barcode:" + barcode[1:]
+	}
+	query := url.Values{}
+	query.Set("query", q)
+	result := struct {
+		Releases []Release `json:"releases"`
+	}{}
+	err := m.get(ctx, "release", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Releases) == 0 {
+		return nil, ErrNotFound
+	}
+	return result.Releases, nil
+}
+
+// Look up the releases for a disc, falling back to a barcode search.
+//
+// The disc ID is looked up first. If it is unknown to MusicBrainz and the
+// disc has a valid MCN, the releases with this MCN as barcode are returned
+// instead. Returns ErrNotFound if neither lookup found a release.
+func (m *MusicBrainz) LookupWithBarcodeFallback(ctx context.Context, disc discid.Snapshot) ([]Release, error) {
+	releases, err := m.LookupDiscId(ctx, disc.Id)
+	if err == ErrNotFound && discid.IsValidMcn(disc.Mcn) {
+		return m.SearchBarcode(ctx, disc.Mcn)
+	}
+	return releases, err
+}
+
+// Look up the releases for a disc, implementing the Lookup interface.
+//
+// The releases are looked up with LookupWithBarcodeFallback. Matches by
+// disc ID are exact and include the tracks of the medium with the disc ID.
+func (m *MusicBrainz) Lookup(ctx context.Context, disc discid.Snapshot) ([]ReleaseMatch, error) {
+	releases, err := m.LookupWithBarcodeFallback(ctx, disc)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]ReleaseMatch, 0, len(releases))
+	for _, release := range releases {
+		match := ReleaseMatch{
+			Source: "musicbrainz",
+			Id:     release.Id,
+			Title:  release.Title,
+			Artist: release.Artist(),
+			Date:   release.Date,
+		}
+		if medium := release.MediumWithDiscId(disc.Id); medium != nil {
+			match.Exact = true
+			for _, track := range medium.Tracks {
+				match.Tracks = append(match.Tracks, TrackMatch{
+					Position:    track.Position,
+					Title:       track.Title,
+					Length:      time.Duration(track.Length) * time.Millisecond,
+					Id:          track.Id,
+					RecordingId: track.Recording.Id,
+				})
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+func (m *MusicBrainz) get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	baseUrl := m.BaseUrl
+	if baseUrl == "" {
+		baseUrl = DefaultMusicBrainzUrl
+	}
+	query.Set("fmt", "json")
+	u := strings.TrimSuffix(baseUrl, "/") + "/" + path + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	userAgent := m.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if m.Auth != nil {
+		token, err := m.Auth.AccessToken(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	client := m.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("MusicBrainz request failed with status %v", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(handler http.HandlerFunc) (*lookup.MusicBrainz, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	return &lookup.MusicBrainz{BaseUrl: server.URL, UserAgent: "go-discid-test/1.0"}, server
+}
+
+func TestLookupDiscId(t *testing.T) {
+	assert := assert.New(t)
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/discid/Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-", r.URL.Path)
+		assert.Equal("json", r.URL.Query().Get("fmt"))
+		assert.Equal("recordings isrcs artist-credits labels", r.URL.Query().Get("inc"))
+		assert.Equal("go-discid-test/1.0", r.Header.Get("User-Agent"))
+		http.ServeFile(w, r, "testdata/discid.json")
+	})
+	defer server.Close()
+	releases, err := mb.LookupDiscId(context.Background(), "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(releases, 1)
+	release := releases[0]
+	assert.Equal("Test Album", release.Title)
+	assert.Equal("Foo & Bar", release.Artist())
+	medium := release.MediumWithDiscId("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-")
+	if assert.NotNil(medium) {
+		assert.Len(medium.Tracks, 3)
+		assert.Equal([]string{"DEA123400001"}, medium.Tracks[0].Recording.Isrcs)
+		assert.Equal([]int{150, 20000, 40000}, medium.Discs[0].Offsets)
+	}
+	assert.Nil(release.MediumWithDiscId("unknown"))
+}
+
+func TestLookupDefaultUserAgent(t *testing.T) {
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, lookup.DefaultUserAgent, r.Header.Get("User-Agent"))
+		http.ServeFile(w, r, "testdata/discid.json")
+	})
+	defer server.Close()
+	mb.UserAgent = ""
+	_, err := mb.LookupDiscId(context.Background(), "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-")
+	assert.NoError(t, err)
+}
+
+func TestLookupDiscIdNotFound(t *testing.T) {
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "Not Found"}`, http.StatusNotFound)
+	})
+	defer server.Close()
+	_, err := mb.LookupDiscId(context.Background(), "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-")
+	assert.Equal(t, lookup.ErrNotFound, err)
+}
+
+func TestSearchBarcode(t *testing.T) {
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/release", r.URL.Path)
+		assert.Equal(t, "barcode:0724384260927 OR barcode:724384260927", r.URL.Query().Get("query"))
+		w.Write([]byte(`{"releases": [{"id": "abc", "title": "Found", "barcode": "724384260927"}]}`))
+	})
+	defer server.Close()
+	releases, err := mb.SearchBarcode(context.Background(), "0724384260927")
+	if assert.NoError(t, err) && assert.Len(t, releases, 1) {
+		assert.Equal(t, "Found", releases[0].Title)
+	}
+}
+
+func TestLookupWithBarcodeFallback(t *testing.T) {
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/release" {
+			w.Write([]byte(`{"releases": [{"id": "abc", "title": "Found"}]}`))
+		} else {
+			http.NotFound(w, r)
+		}
+	})
+	defer server.Close()
+	disc := discid.Snapshot{Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-", Mcn: "4006381333931"}
+	releases, err := mb.LookupWithBarcodeFallback(context.Background(), disc)
+	if assert.NoError(t, err) && assert.Len(t, releases, 1) {
+		assert.Equal(t, "abc", releases[0].Id)
+	}
+
+	disc.Mcn = "0000000000000"
+	_, err = mb.LookupWithBarcodeFallback(context.Background(), disc)
+	assert.Equal(t, lookup.ErrNotFound, err)
+}
+
+func TestMusicBrainzLookup(t *testing.T) {
+	assert := assert.New(t)
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/discid.json")
+	})
+	defer server.Close()
+	disc := discid.Snapshot{Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"}
+	matches, err := mb.Lookup(context.Background(), disc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(matches, 1) {
+		return
+	}
+	match := matches[0]
+	assert.Equal("musicbrainz", match.Source)
+	assert.Equal("Test Album", match.Title)
+	assert.Equal("Foo & Bar", match.Artist)
+	assert.True(match.Exact)
+	assert.Len(match.Tracks, 3)
+	assert.Equal(1, match.Tracks[0].Position)
+	assert.Equal("t1", match.Tracks[0].Id)
+	assert.Equal("r1", match.Tracks[0].RecordingId)
+	assert.Equal(264666*time.Millisecond, match.Tracks[0].Length)
+}
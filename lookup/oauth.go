@@ -0,0 +1,234 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The default base URL of the MusicBrainz OAuth2 endpoints
+const DefaultOAuthUrl = "https://musicbrainz.org/oauth2/"
+
+// The redirect URI for installed applications. MusicBrainz then displays the
+// authorization code to the user, who has to enter it in the application.
+const OutOfBandRedirectUri = "urn:ietf:wg:oauth:2.0:oob"
+
+// OAuth2 scopes of the MusicBrainz API
+const (
+	ScopeProfile       = "profile"
+	ScopeEmail         = "email"
+	ScopeTag           = "tag"
+	ScopeRating        = "rating"
+	ScopeCollection    = "collection"
+	ScopeSubmitIsrc    = "submit_isrc"
+	ScopeSubmitBarcode = "submit_barcode"
+)
+
+// Returned if an authenticated request is made without a token.
+var ErrNotAuthorized = errors.New("not authorized")
+
+// An OAuth2 access token with its refresh token
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// The expiry time, zero if the token does not expire
+	Expiry time.Time `json:"expiry"`
+}
+
+// Report whether the access token is set and not yet expired.
+func (t Token) Valid() bool {
+	return t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Before(t.Expiry))
+}
+
+// OAuth2 authentication for the MusicBrainz web service.
+//
+// Register the application at https://musicbrainz.org/account/applications
+// to get the client ID and secret. Set OAuth as MusicBrainz.Auth to send
+// authenticated requests.
+//
+// MusicBrainz does not support the OAuth2 device flow. For applications
+// without a web server, use OutOfBandRedirectUri and Authorize, which lets
+// the user open the authorization URL in a browser and enter the displayed
+// code.
+type OAuth struct {
+	// Base URL of the OAuth2 endpoints. Defaults to DefaultOAuthUrl.
+	BaseUrl      string
+	ClientId     string
+	ClientSecret string
+	// The redirect URI registered for the application. Defaults to
+	// OutOfBandRedirectUri.
+	RedirectUri string
+	// The requested scopes, e.g. ScopeSubmitIsrc.
+	Scopes []string
+	// The HTTP client used for requests. If nil http.DefaultClient is used.
+	Client *http.Client
+	// Called whenever a new token was received, might be nil. Use this to
+	// store the refresh token, so the user does not need to authorize the
+	// application again.
+	SaveToken func(token Token) error
+
+	mu    sync.Mutex
+	token Token
+}
+
+// Set the token, e.g. a token stored by OAuth.SaveToken earlier.
+func (o *OAuth) SetToken(token Token) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.token = token
+}
+
+// Return the URL for authorizing the application.
+//
+// The user needs to open the URL in a browser. state is passed back to the
+// redirect URI and should be used to prevent CSRF attacks, it can be empty
+// with OutOfBandRedirectUri. A refresh token is requested.
+func (o *OAuth) AuthorizationUrl(state string) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", o.ClientId)
+	query.Set("redirect_uri", o.redirectUri())
+	query.Set("scope", strings.Join(o.Scopes, " "))
+	query.Set("access_type", "offline")
+	if state != "" {
+		query.Set("state", state)
+	}
+	return o.endpoint("authorize") + "?" + query.Encode()
+}
+
+// Authorize the application interactively.
+//
+// prompt gets called with the authorization URL and must return the code
+// the user received after authorizing the application, e.g. by printing the
+// URL and reading the code from the terminal.
+func (o *OAuth) Authorize(ctx context.Context, prompt func(authorizationUrl string) (string, error)) (Token, error) {
+	code, err := prompt(o.AuthorizationUrl(""))
+	if err != nil {
+		return Token{}, err
+	}
+	return o.Exchange(ctx, strings.TrimSpace(code))
+}
+
+// Exchange an authorization code for a token.
+//
+// The token is used for subsequent requests and passed to OAuth.SaveToken.
+func (o *OAuth) Exchange(ctx context.Context, code string) (Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", o.redirectUri())
+	return o.requestToken(ctx, form, "")
+}
+
+// Return a valid access token, refreshing it if it has expired.
+//
+// Returns ErrNotAuthorized if there is no token or the token expired and
+// cannot be refreshed.
+func (o *OAuth) AccessToken(ctx context.Context) (string, error) {
+	o.mu.Lock()
+	token := o.token
+	o.mu.Unlock()
+	if token.Valid() {
+		return token.AccessToken, nil
+	}
+	if token.RefreshToken == "" {
+		return "", ErrNotAuthorized
+	}
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", token.RefreshToken)
+	token, err := o.requestToken(ctx, form, token.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// Request a token from the token endpoint. If the response does not contain
+// a refresh token, refreshToken is kept.
+func (o *OAuth) requestToken(ctx context.Context, form url.Values, refreshToken string) (Token, error) {
+	form.Set("client_id", o.ClientId)
+	form.Set("client_secret", o.ClientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.endpoint("token"),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	client := o.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Token{}, err
+	}
+	defer resp.Body.Close()
+	result := struct {
+		Token
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil && resp.StatusCode == http.StatusOK {
+		return Token{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		if result.Error != "" {
+			return Token{}, fmt.Errorf("MusicBrainz token request failed: %v %v", result.Error, result.ErrorDescription)
+		}
+		return Token{}, fmt.Errorf("MusicBrainz token request failed with status %v", resp.Status)
+	}
+	token := result.Token
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	o.SetToken(token)
+	if o.SaveToken != nil {
+		if err := o.SaveToken(token); err != nil {
+			return token, err
+		}
+	}
+	return token, nil
+}
+
+func (o *OAuth) endpoint(name string) string {
+	baseUrl := o.BaseUrl
+	if baseUrl == "" {
+		baseUrl = DefaultOAuthUrl
+	}
+	return strings.TrimSuffix(baseUrl, "/") + "/" + name
+}
+
+func (o *OAuth) redirectUri() string {
+	if o.RedirectUri == "" {
+		return OutOfBandRedirectUri
+	}
+	return o.RedirectUri
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newOAuthServer(handler http.HandlerFunc) (*lookup.OAuth, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	return &lookup.OAuth{
+		BaseUrl:      server.URL,
+		ClientId:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{lookup.ScopeProfile, lookup.ScopeSubmitIsrc},
+	}, server
+}
+
+func TestOAuthAuthorizationUrl(t *testing.T) {
+	auth := lookup.OAuth{ClientId: "client", Scopes: []string{lookup.ScopeSubmitIsrc}}
+	u, err := url.Parse(auth.AuthorizationUrl("xyz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert := assert.New(t)
+	assert.Equal("https://musicbrainz.org/oauth2/authorize", u.Scheme+"://"+u.Host+u.Path)
+	query := u.Query()
+	assert.Equal("code", query.Get("response_type"))
+	assert.Equal("client", query.Get("client_id"))
+	assert.Equal(lookup.OutOfBandRedirectUri, query.Get("redirect_uri"))
+	assert.Equal("submit_isrc", query.Get("scope"))
+	assert.Equal("xyz", query.Get("state"))
+}
+
+func TestOAuthAuthorize(t *testing.T) {
+	assert := assert.New(t)
+	auth, server := newOAuthServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/token", r.URL.Path)
+		assert.Equal("authorization_code", r.FormValue("grant_type"))
+		assert.Equal("the-code", r.FormValue("code"))
+		assert.Equal("client", r.FormValue("client_id"))
+		assert.Equal("secret", r.FormValue("client_secret"))
+		w.Write([]byte(`{"access_token":"access","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh"}`))
+	})
+	defer server.Close()
+	saved := lookup.Token{}
+	auth.SaveToken = func(token lookup.Token) error {
+		saved = token
+		return nil
+	}
+	token, err := auth.Authorize(context.Background(), func(u string) (string, error) {
+		return "the-code\n", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("access", token.AccessToken)
+	assert.Equal("refresh", token.RefreshToken)
+	assert.True(token.Valid())
+	assert.Equal(token, saved)
+	access, err := auth.AccessToken(context.Background())
+	assert.NoError(err)
+	assert.Equal("access", access)
+}
+
+func TestOAuthRefresh(t *testing.T) {
+	assert := assert.New(t)
+	auth, server := newOAuthServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("refresh_token", r.FormValue("grant_type"))
+		assert.Equal("refresh", r.FormValue("refresh_token"))
+		w.Write([]byte(`{"access_token":"new","token_type":"Bearer","expires_in":3600}`))
+	})
+	defer server.Close()
+	saved := lookup.Token{}
+	auth.SaveToken = func(token lookup.Token) error {
+		saved = token
+		return nil
+	}
+	auth.SetToken(lookup.Token{AccessToken: "old", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Minute)})
+	access, err := auth.AccessToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("new", access)
+	assert.Equal("refresh", saved.RefreshToken)
+}
+
+func TestTokenJsonWithoutExpiry(t *testing.T) {
+	data, err := json.Marshal(lookup.Token{AccessToken: "access", TokenType: "Bearer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var token lookup.Token
+	assert.NoError(t, json.Unmarshal(data, &token))
+	assert.True(t, token.Expiry.IsZero())
+	assert.True(t, token.Valid())
+}
+
+func TestOAuthNotAuthorized(t *testing.T) {
+	auth := lookup.OAuth{}
+	_, err := auth.AccessToken(context.Background())
+	assert.Equal(t, lookup.ErrNotAuthorized, err)
+}
+
+func TestOAuthError(t *testing.T) {
+	auth, server := newOAuthServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant","error_description":"Invalid code"}`))
+	})
+	defer server.Close()
+	_, err := auth.Exchange(context.Background(), "wrong")
+	assert.EqualError(t, err, "MusicBrainz token request failed: invalid_grant Invalid code")
+}
+
+func TestMusicBrainzAuth(t *testing.T) {
+	mb, server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		http.ServeFile(w, r, "testdata/discid.json")
+	})
+	defer server.Close()
+	mb.Auth = &lookup.OAuth{}
+	mb.Auth.SetToken(lookup.Token{AccessToken: "access"})
+	_, err := mb.LookupDiscId(context.Background(), "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-")
+	assert.NoError(t, err)
+}
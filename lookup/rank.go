@@ -0,0 +1,116 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import (
+	"sort"
+	"strings"
+)
+
+// Preferences for ranking releases with RankReleases
+type RankPreferences struct {
+	// The MCN read from the disc. Releases with this barcode are preferred.
+	Mcn string
+	// Preferred release countries as ISO 3166-1 codes, e.g. "DE" or "XE",
+	// the most preferred first
+	Countries []string
+	// Preferred label names, compared case-insensitively
+	Labels []string
+}
+
+// A release with its ranking score
+type RankedRelease struct {
+	Release Release
+	// The score, higher is better
+	Score int
+}
+
+// Scores for the ranking criteria. A matching barcode outweighs all other
+// criteria, as it identifies the exact edition.
+const (
+	scoreBarcode  = 100
+	scoreCountry  = 10
+	scoreLabel    = 5
+	scoreOfficial = 2
+	scoreDate     = 1
+)
+
+// Rank the releases matching a disc ID by the given preferences.
+//
+// If a disc ID is attached to multiple releases this helps to auto-select
+// the most likely one or to present the candidates in a sensible order.
+// Releases are scored by barcode matching the MCN, preferred country,
+// preferred label, official status and having a release date. Releases
+// with the same score are ordered by release date, earliest first, and
+// then keep their original order.
+func RankReleases(releases []Release, prefs RankPreferences) []RankedRelease {
+	ranked := make([]RankedRelease, len(releases))
+	for i, release := range releases {
+		ranked[i] = RankedRelease{release, scoreRelease(release, prefs)}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Release.Date != "" && (b.Release.Date == "" || a.Release.Date < b.Release.Date)
+	})
+	return ranked
+}
+
+func scoreRelease(release Release, prefs RankPreferences) int {
+	score := 0
+	if prefs.Mcn != "" && sameBarcode(release.Barcode, prefs.Mcn) {
+		score += scoreBarcode
+	}
+	for i, country := range prefs.Countries {
+		if strings.EqualFold(release.Country, country) {
+			// Earlier countries in the list score higher
+			score += scoreCountry * (len(prefs.Countries) - i)
+			break
+		}
+	}
+	if hasLabel(release, prefs.Labels) {
+		score += scoreLabel
+	}
+	if release.Status == "Official" {
+		score += scoreOfficial
+	}
+	if release.Date != "" {
+		score += scoreDate
+	}
+	return score
+}
+
+// Compare barcodes, treating a 12 digit UPC and the 13 digit EAN with a
+// leading zero as equal.
+func sameBarcode(a string, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	return strings.TrimPrefix(a, "0") == strings.TrimPrefix(b, "0")
+}
+
+func hasLabel(release Release, labels []string) bool {
+	for _, info := range release.LabelInfo {
+		for _, label := range labels {
+			if strings.EqualFold(info.Label.Name, label) {
+				return true
+			}
+		}
+	}
+	return false
+}
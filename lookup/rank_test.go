@@ -0,0 +1,61 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankReleases(t *testing.T) {
+	var releases []lookup.Release
+	err := json.Unmarshal([]byte(`[
+		{"id": "plain"},
+		{"id": "us", "country": "US", "date": "1995", "status": "Official"},
+		{"id": "de", "country": "DE", "date": "1996"},
+		{"id": "barcode", "barcode": "724384260927"},
+		{"id": "label", "country": "DE", "date": "1997",
+		 "label-info": [{"label": {"name": "Some Label"}}]},
+		{"id": "early", "country": "DE", "date": "1994"}
+	]`), &releases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranked := lookup.RankReleases(releases, lookup.RankPreferences{
+		Mcn:       "0724384260927",
+		Countries: []string{"de", "US"},
+		Labels:    []string{"some label"},
+	})
+	ids := []string{}
+	for _, r := range ranked {
+		ids = append(ids, r.Release.Id)
+	}
+	assert.Equal(t, []string{"barcode", "label", "early", "de", "us", "plain"}, ids)
+	assert.Equal(t, 100, ranked[0].Score)
+	assert.Equal(t, 26, ranked[1].Score)
+	assert.Equal(t, 0, ranked[5].Score)
+}
+
+func TestRankReleasesNoPreferences(t *testing.T) {
+	releases := []lookup.Release{{Id: "a"}, {Id: "b", Date: "2001"}, {Id: "c", Date: "1999"}}
+	ranked := lookup.RankReleases(releases, lookup.RankPreferences{})
+	assert.Equal(t, "c", ranked[0].Release.Id)
+	assert.Equal(t, "b", ranked[1].Release.Id)
+	assert.Equal(t, "a", ranked[2].Release.Id)
+}
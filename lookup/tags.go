@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup
+
+import "strconv"
+
+// Tag field names as used for Vorbis comments by MusicBrainz Picard.
+//
+// Writers for other tag formats, e.g. ID3, need to map these names to
+// their own frames.
+const (
+	TagTitle                     = "TITLE"
+	TagArtist                    = "ARTIST"
+	TagAlbum                     = "ALBUM"
+	TagAlbumArtist               = "ALBUMARTIST"
+	TagDate                      = "DATE"
+	TagTrackNumber               = "TRACKNUMBER"
+	TagTrackTotal                = "TRACKTOTAL"
+	TagIsrc                      = "ISRC"
+	TagBarcode                   = "BARCODE"
+	TagMusicBrainzDiscId         = "MUSICBRAINZ_DISCID"
+	TagMusicBrainzAlbumId        = "MUSICBRAINZ_ALBUMID"
+	TagMusicBrainzTrackId        = "MUSICBRAINZ_TRACKID"
+	TagMusicBrainzReleaseTrackId = "MUSICBRAINZ_RELEASETRACKID"
+)
+
+// Tag fields of a track, mapping the field name to its value
+type Tags map[string]string
+
+// Return the tag fields for each track, keyed by track number.
+//
+// The fields contain the disc ID and ISRC read from the disc and, if a
+// release was found, the release and track titles and artists. The
+// MusicBrainz IDs are only set for releases from MusicBrainz. Empty values
+// are left out. Data tracks are skipped.
+func (m DiscMetadata) Tags() map[int]Tags {
+	audio := 0
+	for _, track := range m.Tracks {
+		if !track.Data {
+			audio++
+		}
+	}
+	result := make(map[int]Tags)
+	position := 0
+	for _, track := range m.Tracks {
+		if track.Data {
+			continue
+		}
+		position++
+		tags := Tags{}
+		set := func(name string, value string) {
+			if value != "" {
+				tags[name] = value
+			}
+		}
+		set(TagTrackNumber, strconv.Itoa(position))
+		set(TagTrackTotal, strconv.Itoa(audio))
+		set(TagIsrc, track.Isrc)
+		set(TagBarcode, m.Disc.Mcn)
+		set(TagMusicBrainzDiscId, m.Disc.Id)
+		if release := m.Release; release != nil {
+			set(TagTitle, track.Title)
+			set(TagArtist, track.Artist)
+			set(TagAlbum, release.Title)
+			set(TagAlbumArtist, release.Artist)
+			set(TagDate, release.Date)
+			if isMusicBrainz(release.Source) {
+				set(TagMusicBrainzAlbumId, release.Id)
+				set(TagMusicBrainzTrackId, track.RecordingId)
+				set(TagMusicBrainzReleaseTrackId, track.TrackId)
+			}
+		}
+		result[track.Number] = tags
+	}
+	return result
+}
+
+func isMusicBrainz(source string) bool {
+	return source == "musicbrainz" || source == "musicbrainz-dump"
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lookup_test
+
+import (
+	"testing"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscMetadataTags(t *testing.T) {
+	metadata := lookup.DiscMetadata{
+		Disc: discid.Snapshot{Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-", Mcn: "4006381333931"},
+		Release: &lookup.ReleaseMatch{
+			Source: "musicbrainz",
+			Id:     "abc",
+			Title:  "Test Album",
+			Artist: "Foo",
+			Date:   "1995",
+		},
+		Tracks: []lookup.TrackMetadata{
+			{
+				Track:       discid.Track{Number: 1, Isrc: "DEA123400001"},
+				Title:       "One",
+				Artist:      "Foo",
+				TrackId:     "t1",
+				RecordingId: "r1",
+			},
+			{Track: discid.Track{Number: 2}, Title: "Two", Artist: "Bar"},
+			{Track: discid.Track{Number: 3, Data: true}},
+		},
+	}
+	tags := metadata.Tags()
+	assert.Len(t, tags, 2)
+	assert.Equal(t, lookup.Tags{
+		lookup.TagTitle:                     "One",
+		lookup.TagArtist:                    "Foo",
+		lookup.TagAlbum:                     "Test Album",
+		lookup.TagAlbumArtist:               "Foo",
+		lookup.TagDate:                      "1995",
+		lookup.TagTrackNumber:               "1",
+		lookup.TagTrackTotal:                "2",
+		lookup.TagIsrc:                      "DEA123400001",
+		lookup.TagBarcode:                   "4006381333931",
+		lookup.TagMusicBrainzDiscId:         "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-",
+		lookup.TagMusicBrainzAlbumId:        "abc",
+		lookup.TagMusicBrainzTrackId:        "r1",
+		lookup.TagMusicBrainzReleaseTrackId: "t1",
+	}, tags[1])
+	assert.Equal(t, "Bar", tags[2][lookup.TagArtist])
+	assert.NotContains(t, tags[2], lookup.TagIsrc)
+}
+
+func TestDiscMetadataTagsGnudb(t *testing.T) {
+	metadata := lookup.DiscMetadata{
+		Disc:    discid.Snapshot{Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"},
+		Release: &lookup.ReleaseMatch{Source: "gnudb", Id: "rock/830abf0a", Title: "Test Album"},
+		Tracks:  []lookup.TrackMetadata{{Track: discid.Track{Number: 1}, Title: "One"}},
+	}
+	tags := metadata.Tags()
+	assert.Equal(t, "Test Album", tags[1][lookup.TagAlbum])
+	assert.NotContains(t, tags[1], lookup.TagMusicBrainzAlbumId)
+}
+
+func TestDiscMetadataTagsNoRelease(t *testing.T) {
+	metadata := lookup.DiscMetadata{
+		Disc:   discid.Snapshot{Id: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"},
+		Tracks: []lookup.TrackMetadata{{Track: discid.Track{Number: 1}}},
+	}
+	assert.Equal(t, map[int]lookup.Tags{1: {
+		lookup.TagTrackNumber:       "1",
+		lookup.TagTrackTotal:        "1",
+		lookup.TagMusicBrainzDiscId: "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-",
+	}}, metadata.Tags())
+}
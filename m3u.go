@@ -0,0 +1,68 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Options for writing a playlist with Snapshot.WriteM3u
+type M3uOptions struct {
+	// Return the file name of the track, e.g. "01 Title.flac". Defaults to
+	// the track number with the extension ".flac", e.g. "01.flac".
+	FileName func(track Track) string
+	// Return the title of the track shown by players. Defaults to
+	// "Track N". The EXTINF convention is "Artist - Title".
+	Title func(track Track) string
+}
+
+// Write an extended M3U playlist with UTF-8 encoding (.m3u8) for the audio
+// tracks.
+//
+// Each track gets an EXTINF line with its duration in seconds and its title
+// followed by the file name, see M3uOptions. Data tracks are skipped.
+func (s Snapshot) WriteM3u(w io.Writer, opts M3uOptions) error {
+	fileName := opts.FileName
+	if fileName == nil {
+		fileName = func(track Track) string {
+			return fmt.Sprintf("%02d.flac", track.Number)
+		}
+	}
+	title := opts.Title
+	if title == nil {
+		title = func(track Track) string {
+			return fmt.Sprintf("Track %v", track.Number)
+		}
+	}
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n")
+	for _, track := range s.Tracks {
+		if track.Data {
+			continue
+		}
+		seconds := (track.Sectors + SectorsPerSecond/2) / SectorsPerSecond
+		fmt.Fprintf(&b, "#EXTINF:%d,%v\n%v\n", seconds, singleLine(title(track)), singleLine(fileName(track)))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Replace line breaks, which would break the playlist format.
+func singleLine(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+}
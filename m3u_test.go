@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestWriteM3u(t *testing.T) {
+	disc, err := discid.Parse("1 2 39738 150 18901")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	var b strings.Builder
+	if err := disc.Snapshot().WriteM3u(&b, discid.M3uOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "#EXTM3U\n"+
+		"#EXTINF:250,Track 1\n01.flac\n"+
+		"#EXTINF:278,Track 2\n02.flac\n", b.String())
+}
+
+func TestWriteM3uOptions(t *testing.T) {
+	s := discid.Snapshot{Tracks: []discid.Track{
+		{Number: 1, Offset: 150, Sectors: 750},
+		{Number: 2, Offset: 12300, Sectors: 30000, Data: true},
+	}}
+	var b strings.Builder
+	err := s.WriteM3u(&b, discid.M3uOptions{
+		FileName: func(track discid.Track) string { return "music/first.ogg" },
+		Title:    func(track discid.Track) string { return "Artist - Title\nwith break" },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "#EXTM3U\n#EXTINF:10,Artist - Title with break\nmusic/first.ogg\n", b.String())
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// The version of the manifest format written by Manifest.WriteJson
+const ManifestFormatVersion = 1
+
+// Information about the drive a disc was read with
+type ManifestDrive struct {
+	// The device name as passed for reading the disc
+	Device string `json:"device"`
+	// The device node the name refers to, see discid.ResolveDevice
+	Node string `json:"node,omitempty"`
+	// The alias registered for the device, if any
+	Alias    string `json:"alias,omitempty"`
+	Vendor   string `json:"vendor,omitempty"`
+	Model    string `json:"model,omitempty"`
+	Revision string `json:"revision,omitempty"`
+	// The drive capabilities, if available on this platform
+	Capabilities *DriveCapabilities `json:"capabilities,omitempty"`
+}
+
+// A rip manifest documents the provenance of ripped audio files.
+//
+// It combines all data read from the disc with information about the drive
+// and timestamps in a single JSON document, which is designed to be stored
+// next to the ripped files for archival purposes.
+type Manifest struct {
+	FormatVersion int `json:"format_version"`
+	// All data read from the disc
+	Disc Snapshot `json:"disc"`
+	// URL for submitting the disc ID to MusicBrainz
+	SubmissionUrl string        `json:"submission_url"`
+	Drive         ManifestDrive `json:"drive"`
+	// The version of libdiscid used for reading the disc
+	LibdiscidVersion string `json:"libdiscid_version,omitempty"`
+	// The time the disc was read
+	ReadAt time.Time `json:"read_at"`
+	// The time the manifest was created
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Create a manifest for a disc read from device at the given time.
+//
+// The drive information is collected from the system, as far as supported
+// on the current platform. The creation time is set to the current time.
+func NewManifest(s Snapshot, device string, readAt time.Time) Manifest {
+	drive := ManifestDrive{Device: device}
+	if alias := DeviceDisplayName(device); alias != device {
+		drive.Alias = alias
+	}
+	if path, err := ResolveDevice(device); err == nil {
+		drive.Node = path.Node
+	}
+	node := drive.Node
+	if node == "" {
+		node = device
+	}
+	drive.Vendor, drive.Model, drive.Revision = driveIdentity(node)
+	if drives, err := ListDriveCapabilities(); err == nil {
+		for i := range drives {
+			if drives[i].Device == node {
+				drive.Capabilities = &drives[i]
+				break
+			}
+		}
+	}
+	return Manifest{
+		FormatVersion:    ManifestFormatVersion,
+		Disc:             s,
+		SubmissionUrl:    s.SubmissionUrl(),
+		Drive:            drive,
+		LibdiscidVersion: Version(),
+		ReadAt:           readAt,
+		CreatedAt:        time.Now(),
+	}
+}
+
+// Read the disc in the given drive and create a manifest for it.
+//
+// If device is an empty string the default device is used.
+func ReadManifest(device string, features Feature) (Manifest, error) {
+	if device == "" {
+		device = DefaultDevice()
+	}
+	readAt := time.Now()
+	disc, err := ReadFeatures(device, features)
+	if err != nil {
+		return Manifest{}, err
+	}
+	s := disc.Snapshot()
+	disc.Close()
+	return NewManifest(s, device, readAt), nil
+}
+
+// Write the manifest as indented JSON document.
+func (m Manifest) WriteJson(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(m)
+}
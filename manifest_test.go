@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestManifest(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.Parse("1 1 44942 150")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := disc.Snapshot()
+	disc.Close()
+	readAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	m := discid.NewManifest(s, "notadevice", readAt)
+	assert.Equal(discid.ManifestFormatVersion, m.FormatVersion)
+	assert.Equal(s, m.Disc)
+	assert.Equal(s.SubmissionUrl(), m.SubmissionUrl)
+	assert.Equal("notadevice", m.Drive.Device)
+	assert.Equal(readAt, m.ReadAt)
+	assert.False(m.CreatedAt.Before(readAt))
+}
+
+func TestManifestWriteJson(t *testing.T) {
+	m := discid.Manifest{
+		FormatVersion: 1,
+		Disc: discid.Snapshot{
+			Id:            "ANJa4DGYN_ktpzOwvVPtcjwP7mE-",
+			FreedbId:      "02025701",
+			TocString:     "1 1 44942 150",
+			FirstTrackNum: 1,
+			LastTrackNum:  1,
+			Sectors:       44942,
+			Tracks:        []discid.Track{{Number: 1, Offset: 150, Sectors: 44792}},
+		},
+		SubmissionUrl: "http://musicbrainz.org/cdtoc/attach?id=ANJa4DGYN_ktpzOwvVPtcjwP7mE-&tracks=1&toc=1+1+44942+150",
+		Drive:         discid.ManifestDrive{Device: "/dev/cdrom", Node: "/dev/sr0", Vendor: "ASUS"},
+		ReadAt:        time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		CreatedAt:     time.Date(2026, 3, 1, 12, 5, 0, 0, time.UTC),
+	}
+	var b bytes.Buffer
+	assert.NoError(t, m.WriteJson(&b))
+	assert.JSONEq(t, `{
+		"format_version": 1,
+		"disc": {
+			"id": "ANJa4DGYN_ktpzOwvVPtcjwP7mE-",
+			"freedb_id": "02025701",
+			"toc": "1 1 44942 150",
+			"first_track": 1,
+			"last_track": 1,
+			"sectors": 44942,
+			"tracks": [{"number": 1, "offset": 150, "sectors": 44792}]
+		},
+		"submission_url": "http://musicbrainz.org/cdtoc/attach?id=ANJa4DGYN_ktpzOwvVPtcjwP7mE-&tracks=1&toc=1+1+44942+150",
+		"drive": {"device": "/dev/cdrom", "node": "/dev/sr0", "vendor": "ASUS"},
+		"read_at": "2026-03-01T12:00:00Z",
+		"created_at": "2026-03-01T12:05:00Z"
+	}`, b.String())
+	var decoded discid.Manifest
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &decoded))
+	assert.Equal(t, m, decoded)
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// A disc as returned by the MusicBrainz web service (ws/2) in JSON format,
+// e.g. as part of the media of a release.
+type MBDisc struct {
+	// The MusicBrainz disc ID
+	Id string `json:"id"`
+	// The number of tracks
+	OffsetCount int `json:"offset-count"`
+	// The length of the disc in sectors, which is the leadout offset
+	Sectors int `json:"sectors"`
+	// The start offsets of the tracks in sectors
+	Offsets []int `json:"offsets"`
+}
+
+// Decode the discs of a MusicBrainz medium.
+//
+// data can either be the JSON array of discs as found in the "discs" field
+// of a medium or the JSON object of a medium itself.
+func DecodeMBDiscs(data []byte) ([]MBDisc, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		medium := struct {
+			Discs []MBDisc `json:"discs"`
+		}{}
+		err := json.Unmarshal(trimmed, &medium)
+		return medium.Discs, err
+	}
+	var discs []MBDisc
+	err := json.Unmarshal(trimmed, &discs)
+	return discs, err
+}
+
+// Parse a MusicBrainz disc JSON object into a Disc.
+//
+// See discid.MBDisc.Disc for details.
+func ParseMBDiscJson(data []byte) (Disc, error) {
+	var d MBDisc
+	if err := json.Unmarshal(data, &d); err != nil {
+		return Disc{}, err
+	}
+	return d.Disc()
+}
+
+// Return a Disc with the TOC of the MusicBrainz disc.
+//
+// MusicBrainz does not store the number of the first track, it is assumed to
+// be 1. If the disc ID is set it must match the disc ID calculated from the
+// TOC.
+func (d MBDisc) Disc() (Disc, error) {
+	if len(d.Offsets) == 0 || len(d.Offsets) != d.OffsetCount {
+		return Disc{}, fmt.Errorf("offset count %v does not match %v offsets",
+			d.OffsetCount, len(d.Offsets))
+	}
+	disc, err := Put(1, append([]int{d.Sectors}, d.Offsets...))
+	if err != nil {
+		return disc, err
+	}
+	if d.Id != "" && disc.Id() != d.Id {
+		id := disc.Id()
+		disc.Close()
+		return Disc{}, fmt.Errorf("disc ID %v does not match the TOC with disc ID %v", d.Id, id)
+	}
+	return disc, nil
+}
+
+// Return the disc in the format used by the MusicBrainz web service.
+func (d Disc) MBDisc() MBDisc {
+	offsets := []int{}
+	for n := d.FirstTrackNum(); n <= d.LastTrackNum(); n++ {
+		offsets = append(offsets, d.handle.trackOffset(n))
+	}
+	return MBDisc{
+		Id:          d.Id(),
+		OffsetCount: len(offsets),
+		Sectors:     d.Sectors(),
+		Offsets:     offsets,
+	}
+}
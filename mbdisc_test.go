@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+const mbDiscJson = `{
+	"id": "dbAZ9BEKFrSpz40T9lQ1czFZLPQ-",
+	"offset-count": 13,
+	"sectors": 295070,
+	"offsets": [150, 20109, 42075, 61577, 76710, 95062, 116300, 131857,
+		153462, 177480, 191595, 213282, 233920]
+}`
+
+func TestParseMBDiscJson(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.ParseMBDiscJson([]byte(mbDiscJson))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal("dbAZ9BEKFrSpz40T9lQ1czFZLPQ-", disc.Id())
+	assert.Equal(1, disc.FirstTrackNum())
+	assert.Equal(13, disc.LastTrackNum())
+	assert.Equal(295070, disc.Sectors())
+	assert.Equal(233920, disc.Track(13).Offset)
+
+	mbDisc := disc.MBDisc()
+	assert.Equal(13, mbDisc.OffsetCount)
+	disc2, err := mbDisc.Disc()
+	if assert.NoError(err) {
+		assert.Equal(disc.TocString(), disc2.TocString())
+		disc2.Close()
+	}
+}
+
+func TestParseMBDiscJsonInvalid(t *testing.T) {
+	_, err := discid.ParseMBDiscJson([]byte(`{"offset-count": 2, "sectors": 1000, "offsets": [150]}`))
+	assert.EqualError(t, err, "offset count 2 does not match 1 offsets")
+	_, err = discid.ParseMBDiscJson([]byte(`{"id": "wrong", "offset-count": 1, "sectors": 1000, "offsets": [150]}`))
+	assert.Error(t, err)
+	_, err = discid.ParseMBDiscJson([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestDecodeMBDiscs(t *testing.T) {
+	medium := `{"position": 1, "format": "CD", "discs": [` + mbDiscJson + `]}`
+	discs, err := discid.DecodeMBDiscs([]byte(medium))
+	if assert.NoError(t, err) && assert.Len(t, discs, 1) {
+		assert.Equal(t, "dbAZ9BEKFrSpz40T9lQ1czFZLPQ-", discs[0].Id)
+		assert.Equal(t, 295070, discs[0].Sectors)
+		assert.Len(t, discs[0].Offsets, 13)
+	}
+
+	discs, err = discid.DecodeMBDiscs([]byte(" [" + mbDiscJson + ", {\"id\": \"other\"}]"))
+	if assert.NoError(t, err) && assert.Len(t, discs, 2) {
+		assert.Equal(t, "other", discs[1].Id)
+	}
+
+	_, err = discid.DecodeMBDiscs([]byte("3"))
+	assert.Error(t, err)
+}
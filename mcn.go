@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Check if mcn is a valid Media Catalogue Number.
+//
+// A valid MCN is a 13 digit EAN with a correct check digit. Some discs
+// contain an MCN consisting only of zeros, which is not considered valid.
+func IsValidMcn(mcn string) bool {
+	if len(mcn) != 13 {
+		return false
+	}
+	sum := 0
+	allZero := true
+	for i := 0; i < len(mcn); i++ {
+		c := mcn[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		digit := int(c - '0')
+		if digit != 0 {
+			allZero = false
+		}
+		if i == 12 {
+			break
+		}
+		if i%2 == 0 {
+			sum += digit
+		} else {
+			sum += 3 * digit
+		}
+	}
+	check := (10 - sum%10) % 10
+	return !allZero && check == int(mcn[12]-'0')
+}
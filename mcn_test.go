@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestIsValidMcn(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(discid.IsValidMcn("4006381333931"))
+	assert.True(discid.IsValidMcn("0724384260927"))
+	assert.False(discid.IsValidMcn("0000000000000"))
+	assert.False(discid.IsValidMcn("4006381333932"))
+	assert.False(discid.IsValidMcn("400638133393"))
+	assert.False(discid.IsValidMcn("400638133393A"))
+	assert.False(discid.IsValidMcn(""))
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build discid_stub || (!cgo && !windows) || (!cgo && !discid_dll)
+// +build discid_stub !cgo,!windows !cgo,!discid_dll
+
+package discid
+
+// Without cgo libdiscid is not available. Only the TOC based functions
+// discid.Put and discid.Parse are supported using the pure Go implementation.
+//
+// The same implementation is used with the build tag discid_stub, which
+// allows building without libdiscid even if cgo is available, e.g. for
+// running tests on CI systems lacking the library.
+
+func defaultDevice() string {
+	return ""
+}
+
+func version() string {
+	return ""
+}
+
+func hasFeature(feature Feature) bool {
+	return false
+}
+
+func readHandle(device string, features Feature) (handle, error) {
+	return nil, ErrNotSupported
+}
+
+func putHandle(first int, last int, offsets *[100]int) (handle, error) {
+	h, err := newGoHandle(first, last, offsets)
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// Convert a user provided device name into the form libdiscid expects on
+// the current platform.
+//
+// On Windows drive letters are accepted with or without colon, in lower
+// case and as device path, e.g. "d", "d:", `D:\` and `\\.\D:` all result
+// in "D:". Volume GUID paths like `\\?\Volume{GUID}\` are replaced by the
+// drive letter the volume is currently mounted at. On other platforms names
+// without path, e.g. "sr0", are expanded to "/dev/sr0". On Linux
+// "/dev/cdromN" is mapped to "/dev/srN" if the former does not exist, as
+// modern udev rules only create "/dev/cdrom". On macOS drive numbers like
+// "1" are kept as they are and block devices like "/dev/disk2" or
+// "/dev/disk2s1" as shown by mount are replaced by the faster raw device,
+// e.g. "/dev/rdisk2", see discid.RawDevice. An empty name stays empty and
+// selects the default device. Aliases registered with
+// discid.RegisterDeviceAlias are replaced by the device.
+func NormalizeDevice(name string) string {
+	return normalizeDevice(resolveDeviceName(name), runtime.GOOS, fileExists)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func normalizeDevice(name string, goos string, exists func(path string) bool) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ""
+	}
+
+	if goos == "windows" {
+		drive := strings.TrimPrefix(name, `\\.\`)
+		drive = strings.TrimRight(drive, `\/`)
+		drive = strings.TrimSuffix(drive, ":")
+		if len(drive) == 1 && isAsciiLetter(drive[0]) {
+			return strings.ToUpper(drive) + ":"
+		}
+		return name
+	}
+
+	if goos == "darwin" && isNumeric(name) {
+		return name
+	}
+	if !strings.Contains(name, "/") {
+		name = "/dev/" + name
+	}
+	if goos == "darwin" {
+		return RawDevice(name)
+	}
+	if goos == "linux" && strings.HasPrefix(name, "/dev/cdrom") {
+		number := strings.TrimPrefix(name, "/dev/cdrom")
+		if number != "" && isNumeric(number) && !exists(name) {
+			return "/dev/sr" + number
+		}
+	}
+	return name
+}
+
+// Return the raw device for a macOS disk device.
+//
+// Reading from the raw device, e.g. "/dev/rdisk2", bypasses the buffer
+// cache and is faster than reading from the block device "/dev/disk2".
+// Slice suffixes are removed, e.g. "/dev/disk2s1" results in "/dev/rdisk2".
+// Other names are returned unchanged.
+func RawDevice(name string) string {
+	if number, ok := darwinDiskNumber(name); ok {
+		return "/dev/rdisk" + number
+	}
+	return name
+}
+
+// Return the block device for a macOS disk device, the counterpart of
+// discid.RawDevice. E.g. "/dev/rdisk2" results in "/dev/disk2". Other names
+// are returned unchanged.
+func BlockDevice(name string) string {
+	if number, ok := darwinDiskNumber(name); ok {
+		return "/dev/disk" + number
+	}
+	return name
+}
+
+// Return the disk number of a macOS disk device, e.g. "2" for
+// "/dev/rdisk2s1".
+func darwinDiskNumber(name string) (string, bool) {
+	var disk string
+	switch {
+	case strings.HasPrefix(name, "/dev/rdisk"):
+		disk = strings.TrimPrefix(name, "/dev/rdisk")
+	case strings.HasPrefix(name, "/dev/disk"):
+		disk = strings.TrimPrefix(name, "/dev/disk")
+	default:
+		return "", false
+	}
+	number := disk
+	if i := strings.IndexByte(disk, 's'); i >= 0 {
+		number = disk[:i]
+		if !isNumeric(disk[i+1:]) {
+			return "", false
+		}
+	}
+	return number, isNumeric(number)
+}
+
+func isAsciiLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
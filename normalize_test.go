@@ -0,0 +1,63 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDeviceWindows(t *testing.T) {
+	for _, name := range []string{"D", "d", "d:", "D:", `D:\`, "d:/", `\\.\D:`, " d: "} {
+		assert.Equal(t, "D:", normalizeDevice(name, "windows", fileExists), name)
+	}
+	assert.Equal(t, "", normalizeDevice("", "windows", fileExists))
+	assert.Equal(t, "CdRom0", normalizeDevice("CdRom0", "windows", fileExists))
+}
+
+func TestNormalizeDeviceLinux(t *testing.T) {
+	exists := func(path string) bool { return path == "/dev/cdrom1" }
+	assert.Equal(t, "/dev/sr0", normalizeDevice("sr0", "linux", exists))
+	assert.Equal(t, "/dev/sr0", normalizeDevice("/dev/sr0", "linux", exists))
+	assert.Equal(t, "/dev/sr0", normalizeDevice("/dev/cdrom0", "linux", exists))
+	assert.Equal(t, "/dev/sr0", normalizeDevice("cdrom0", "linux", exists))
+	assert.Equal(t, "/dev/cdrom1", normalizeDevice("/dev/cdrom1", "linux", exists))
+	assert.Equal(t, "/dev/cdrom", normalizeDevice("cdrom", "linux", exists))
+	assert.Equal(t, "", normalizeDevice(" ", "linux", exists))
+}
+
+func TestNormalizeDeviceOther(t *testing.T) {
+	none := func(path string) bool { return false }
+	assert.Equal(t, "1", normalizeDevice("1", "darwin", none))
+	assert.Equal(t, "/dev/rdisk2", normalizeDevice("disk2", "darwin", none))
+	assert.Equal(t, "/dev/rdisk2", normalizeDevice("/dev/disk2s1", "darwin", none))
+	assert.Equal(t, "/dev/rdisk2", normalizeDevice("rdisk2", "darwin", none))
+	assert.Equal(t, "/dev/cd0", normalizeDevice("cd0", "freebsd", none))
+	assert.Equal(t, "/dev/cdrom0", normalizeDevice("cdrom0", "freebsd", none))
+}
+
+func TestRawBlockDevice(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("/dev/rdisk2", RawDevice("/dev/disk2"))
+	assert.Equal("/dev/rdisk12", RawDevice("/dev/disk12s3"))
+	assert.Equal("/dev/rdisk2", RawDevice("/dev/rdisk2"))
+	assert.Equal("/dev/disk2", BlockDevice("/dev/rdisk2"))
+	assert.Equal("/dev/disk2", BlockDevice("/dev/disk2s1"))
+	assert.Equal("/dev/sr0", RawDevice("/dev/sr0"))
+	assert.Equal("/dev/diskette", RawDevice("/dev/diskette"))
+	assert.Equal("/dev/disk2sx", BlockDevice("/dev/disk2sx"))
+}
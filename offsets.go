@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "errors"
+
+// Return the offsets of the disc in the format expected by discid.Put,
+// with the leadout as the first element followed by the track offsets.
+func (d Disc) offsets() []int {
+	return d.Toc().Offsets()
+}
+
+// Return a new Disc with the leadout and all track offsets moved by delta
+// sectors.
+//
+// The MCN, ISRCs, sub-indexes and data track flags are kept. The returned
+// disc must be closed independently of d.
+func (d Disc) Shift(delta int) (Disc, error) {
+	offsets := d.offsets()
+	for i := range offsets {
+		offsets[i] += delta
+		if offsets[i] < 0 {
+			return Disc{}, errors.New("shifted offsets must not be negative")
+		}
+	}
+	shifted, err := Put(d.FirstTrackNum(), offsets)
+	if err != nil {
+		return shifted, err
+	}
+	return withMetadata(shifted.handle, d, delta), nil
+}
+
+// Return a new Disc with the last track removed.
+//
+// The leadout of the returned disc is set to the start of the removed track.
+// If the removed track is a data track following audio tracks, the gap
+// between the audio session and the data session of a multi-session disc
+// (11400 sectors) is subtracted as well, which results in the TOC libdiscid
+// reports for the audio session. The returned disc must be closed
+// independently of d.
+func (d Disc) TrimLastTrack() (Disc, error) {
+	first := d.FirstTrackNum()
+	last := d.LastTrackNum()
+	if first == last {
+		return Disc{}, errors.New("cannot remove the only track")
+	}
+	offsets := d.offsets()
+	leadout := offsets[len(offsets)-1]
+	if d.Track(last).Data && !d.Track(last-1).Data {
+		leadout -= dataSessionGap
+	}
+	offsets = offsets[:len(offsets)-1]
+	offsets[0] = leadout
+	trimmed, err := Put(first, offsets)
+	if err != nil {
+		return trimmed, err
+	}
+	return withMetadata(trimmed.handle, d, 0), nil
+}
+
+// Wrap the handle h of a disc created from the TOC of d and copy the MCN and
+// the per track data of d, moving the sub-indexes by delta sectors. Only the
+// tracks contained in h are copied.
+func withMetadata(h handle, d Disc, delta int) Disc {
+	overlay := overlayHandle{
+		handle:  h,
+		mcnStr:  d.Mcn(),
+		isrcs:   make(map[int]string),
+		indexes: make(map[int][]int),
+		data:    make(map[int]bool),
+	}
+	for n := h.firstTrackNum(); n <= h.lastTrackNum(); n++ {
+		track := d.Track(n)
+		overlay.isrcs[n] = track.Isrc
+		overlay.data[n] = track.Data
+		for _, index := range track.Indexes {
+			overlay.indexes[n] = append(overlay.indexes[n], index+delta)
+		}
+	}
+	return Disc{overlay}
+}
+
+// Compare the TOCs of two discs ignoring a constant offset.
+//
+// If b has the same track layout as a with the leadout and all track offsets
+// moved by the same number of sectors, this delta is returned together with
+// true. Otherwise false is returned.
+func OffsetDelta(a Disc, b Disc) (int, bool) {
+	if a.FirstTrackNum() != b.FirstTrackNum() || a.LastTrackNum() != b.LastTrackNum() {
+		return 0, false
+	}
+	offsetsA := a.offsets()
+	offsetsB := b.offsets()
+	delta := offsetsB[0] - offsetsA[0]
+	for i := range offsetsA {
+		if offsetsB[i]-offsetsA[i] != delta {
+			return 0, false
+		}
+	}
+	return delta, true
+}
+
+// The number of sectors of the pregap before the first track. Track offsets
+// in a TOC include the pregap, logical block addresses (LBA) do not.
+const pregapSectors = 2 * SectorsPerSecond
+
+// Create a Disc from the logical block addresses of consecutive tracks,
+// starting with track number first, and the address of the leadout.
+func putLbas(first int, lbas []int, leadout int) (Disc, error) {
+	if len(lbas) == 0 {
+		return Disc{}, errors.New("no tracks found")
+	}
+	offsets := []int{leadout + pregapSectors}
+	for _, lba := range lbas {
+		offsets = append(offsets, lba+pregapSectors)
+	}
+	return Put(first, offsets)
+}
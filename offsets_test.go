@@ -0,0 +1,152 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestShift(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.Parse("1 3 300000 150 100000 200000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	shifted, err := disc.Shift(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shifted.Close()
+	assert.Equal("1 3 300030 180 100030 200030", shifted.TocString())
+	shifted, err = disc.Shift(-150)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shifted.Close()
+	assert.Equal("1 3 299850 0 99850 199850", shifted.TocString())
+	_, err = disc.Shift(-151)
+	assert.Error(err)
+}
+
+func TestShiftKeepsMetadata(t *testing.T) {
+	assert := assert.New(t)
+	cue := `CATALOG 4006381333931
+FILE "disc.wav" WAVE
+  TRACK 01 AUDIO
+    ISRC DEA123400001
+    INDEX 01 00:00:00
+    INDEX 02 00:10:00
+  TRACK 02 AUDIO
+    INDEX 01 10:00:00
+`
+	disc, err := discid.ParseCueSheet(strings.NewReader(cue), 90000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	shifted, err := disc.Shift(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shifted.Close()
+	assert.Equal("4006381333931", shifted.Mcn())
+	assert.Equal("DEA123400001", shifted.Track(1).Isrc)
+	assert.Equal([]int{910}, shifted.Track(1).Indexes)
+}
+
+func TestTrimLastTrack(t *testing.T) {
+	disc, err := discid.Parse("1 3 300000 150 100000 200000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	trimmed, err := disc.TrimLastTrack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer trimmed.Close()
+	assert.Equal(t, "1 2 200000 150 100000", trimmed.TocString())
+}
+
+func TestTrimLastTrackDataSession(t *testing.T) {
+	cue := `FILE "disc.bin" BINARY
+  TRACK 01 AUDIO
+    INDEX 01 00:00:00
+  TRACK 02 MODE1/2352
+    INDEX 01 22:32:00
+`
+	disc, err := discid.ParseCueSheet(strings.NewReader(cue), 200000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	trimmed, err := disc.TrimLastTrack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer trimmed.Close()
+	assert.Equal(t, "1 1 90150 150", trimmed.TocString())
+}
+
+func TestTrimLastTrackSingleTrack(t *testing.T) {
+	disc, err := discid.Parse("1 1 200000 150")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	_, err = disc.TrimLastTrack()
+	assert.Error(t, err)
+}
+
+func TestOffsetDelta(t *testing.T) {
+	assert := assert.New(t)
+	a, err := discid.Parse("1 3 300000 150 100000 200000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	b, err := discid.Parse("1 3 299994 144 99994 199994")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	c, err := discid.Parse("1 3 300000 150 100001 200000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	d, err := discid.Parse("1 2 300000 150 100000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	delta, ok := discid.OffsetDelta(a, b)
+	assert.True(ok)
+	assert.Equal(-6, delta)
+	delta, ok = discid.OffsetDelta(a, a)
+	assert.True(ok)
+	assert.Equal(0, delta)
+	_, ok = discid.OffsetDelta(a, c)
+	assert.False(ok)
+	_, ok = discid.OffsetDelta(a, d)
+	assert.False(ok)
+}
@@ -0,0 +1,326 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Returned by discid.ReadWithOptions if reading the TOC multiple times gave
+// different results, e.g. because of a failing drive or a dirty disc.
+var ErrInconsistentToc = errors.New("inconsistent TOC between reads")
+
+// Returned by discid.ReadWithOptions if no majority was found for a field
+// of the TOC with ReadOptions.MajorityTocReads. The returned error wraps
+// ErrTocNotConverged, use errors.Is to check for it.
+var ErrTocNotConverged = errors.New("TOC reads did not converge")
+
+// Options for reading a disc with discid.ReadWithOptions.
+type ReadOptions struct {
+	// The features to read, see discid.ReadFeatures.
+	Features Feature
+	// How often the ISRCs get read if Features contains discid.FeatureIsrc.
+	//
+	// Reading ISRCs from the subchannel is unreliable with many drives. If
+	// IsrcReads is larger than one the disc gets read multiple times and for
+	// each track the ISRC read most often is used. Values below two result
+	// in a single read.
+	IsrcReads int
+	// If set ISRCs failing basic sanity checks are left empty, e.g.
+	// malformed ISRCs, ISRCs consisting only of zeros or the same ISRC read
+	// for every track.
+	FilterIsrcs bool
+	// If set the disc ID and FreeDB ID returned by the backend are checked
+	// against the values calculated in Go, see discid.Disc.Verify. The read
+	// fails if they differ.
+	Verify bool
+	// How often the TOC gets read. If TocReads is larger than one the disc
+	// gets read multiple times and the read fails with
+	// discid.ErrInconsistentToc if the TOCs differ. Values below two result
+	// in a single read.
+	TocReads int
+	// How often the TOC gets read for a majority vote (paranoid mode).
+	//
+	// If MajorityTocReads is larger than one the disc gets read multiple
+	// times and for the track numbers, the leadout and each track offset
+	// the value read by more than half of the reads is used. If there is no
+	// such value for any field the read fails with discid.ErrTocNotConverged,
+	// which indicates a bad disc or drive. The MCN and the ISRCs of the
+	// first read are kept. Values below two result in a single read.
+	MajorityTocReads int
+	// How long to retry if the drive is not ready yet.
+	//
+	// Drives often report not being ready for a few seconds after a disc
+	// was inserted. If SpinUpWait is larger than zero the read is retried
+	// with exponential backoff as long as the read fails with
+	// discid.ErrNotReady, but at most for SpinUpWait. Other errors, e.g.
+	// discid.ErrNoDisc, are returned immediately.
+	SpinUpWait time.Duration
+	// Timeouts for reading the TOC, the MCN and the ISRCs.
+	//
+	// If any of the timeouts is set, the phases are read one after another
+	// as separate reads, each limited by its timeout. A phase exceeding its
+	// timeout fails the read with discid.ErrTimeout. libdiscid reads the
+	// ISRCs of all tracks at once, hence IsrcTimeout limits the time for
+	// reading all ISRCs. Zero means no timeout.
+	TocTimeout  time.Duration
+	McnTimeout  time.Duration
+	IsrcTimeout time.Duration
+	// Called with the progress of the read, might be nil.
+	//
+	// If set the TOC, MCN and ISRCs are read in separate phases like with
+	// the phase timeouts and the progress is reported before the read and
+	// after each phase.
+	Progress func(progress ReadProgress)
+	// Called with the events of the read, might be nil.
+	//
+	// If set the TOC, MCN and ISRCs are read in separate phases like with
+	// the phase timeouts. Each read ends with either discid.EventCompleted
+	// or discid.EventFailed.
+	Events func(event ReadEvent)
+}
+
+// The first and the maximum delay between retries while waiting for the
+// drive to spin up.
+const (
+	spinUpInitialDelay = 250 * time.Millisecond
+	spinUpMaxDelay     = 2 * time.Second
+)
+
+// Read the disc in the given CD-ROM/DVD-ROM drive with additional options.
+//
+// This function is similar to discid.ReadFeatures, but allows to configure
+// the read further, e.g. to read the ISRCs multiple times. If device is an
+// empty string the default device is used.
+func ReadWithOptions(device string, opts ReadOptions) (disc Disc, err error) {
+	disc, err = readWithOptions(device, opts)
+	if err != nil {
+		opts.emit(ReadEvent{Type: EventFailed, Device: device, Err: err})
+	} else {
+		opts.emit(ReadEvent{Type: EventCompleted, Device: device})
+	}
+	return
+}
+
+func readWithOptions(device string, opts ReadOptions) (disc Disc, err error) {
+	disc, err = readUnfiltered(device, opts)
+	if err == nil && opts.FilterIsrcs && opts.Features&FeatureIsrc != 0 {
+		disc = Disc{overlayHandle{handle: disc.handle, isrcs: filterIsrcs(disc.Snapshot().Tracks)}}
+	}
+	return
+}
+
+func readUnfiltered(device string, opts ReadOptions) (disc Disc, err error) {
+	disc, err = retryNotReady(opts.SpinUpWait, time.Sleep, func() (Disc, error) {
+		return readPhases(device, opts, ReadFeatures)
+	})
+	if err == nil && opts.TocReads > 1 {
+		err = checkTocReads(disc.TocString(), opts.TocReads, func() (string, error) {
+			d, e := ReadFeatures(device, FeatureRead)
+			if e != nil {
+				return "", e
+			}
+			defer d.Close()
+			return d.TocString(), nil
+		})
+		if err != nil {
+			disc.Close()
+			return Disc{}, err
+		}
+	}
+	if err == nil && opts.MajorityTocReads > 1 {
+		disc, err = majorityTocReads(disc, opts.MajorityTocReads, func() (Toc, error) {
+			d, e := ReadFeatures(device, FeatureRead)
+			if e != nil {
+				return Toc{}, e
+			}
+			defer d.Close()
+			return d.Toc(), nil
+		})
+	}
+	if err == nil && opts.Verify {
+		if err = disc.Verify(); err != nil {
+			disc.Close()
+			return Disc{}, err
+		}
+	}
+	if err != nil || opts.Features&FeatureIsrc == 0 || opts.IsrcReads < 2 {
+		return
+	}
+
+	reads := [][]Track{disc.Snapshot().Tracks}
+	for i := 1; i < opts.IsrcReads; i++ {
+		d, e := readWithTimeout("ISRCs", opts.IsrcTimeout, func() (Disc, error) {
+			return ReadFeatures(device, FeatureIsrc)
+		})
+		if e != nil {
+			disc.Close()
+			return Disc{}, e
+		}
+		sameToc := d.TocString() == disc.TocString()
+		tracks := d.Snapshot().Tracks
+		d.Close()
+		if !sameToc {
+			disc.Close()
+			return Disc{}, fmt.Errorf("%w while reading ISRCs", ErrInconsistentToc)
+		}
+		reads = append(reads, tracks)
+	}
+	disc = Disc{overlayHandle{handle: disc.handle, isrcs: majorityIsrcs(reads)}}
+	return
+}
+
+// Call read until it does not fail with ErrNotReady or wait has passed.
+// The delay between the calls doubles after each try.
+func retryNotReady(wait time.Duration, sleep func(time.Duration), read func() (Disc, error)) (Disc, error) {
+	delay := spinUpInitialDelay
+	for {
+		disc, err := read()
+		if wait <= 0 || !errors.Is(err, ErrNotReady) {
+			return disc, err
+		}
+		if delay > wait {
+			delay = wait
+		}
+		sleep(delay)
+		wait -= delay
+		if delay *= 2; delay > spinUpMaxDelay {
+			delay = spinUpMaxDelay
+		}
+	}
+}
+
+// Read the TOC another reads-1 times and compare it to toc.
+func checkTocReads(toc string, reads int, read func() (string, error)) error {
+	for i := 1; i < reads; i++ {
+		other, err := read()
+		if err != nil {
+			return err
+		}
+		if other != toc {
+			return fmt.Errorf("%w: read %q, then %q", ErrInconsistentToc, toc, other)
+		}
+	}
+	return nil
+}
+
+// Read the TOC another reads-1 times and replace the TOC of disc by the
+// majority result. disc is closed if a new disc gets returned.
+func majorityTocReads(disc Disc, reads int, read func() (Toc, error)) (Disc, error) {
+	tocs := []Toc{disc.Toc()}
+	for i := 1; i < reads; i++ {
+		toc, err := read()
+		if err != nil {
+			disc.Close()
+			return Disc{}, err
+		}
+		tocs = append(tocs, toc)
+	}
+	toc, err := majorityToc(tocs)
+	if err != nil {
+		disc.Close()
+		return Disc{}, err
+	}
+	if toc.String() == tocs[0].String() {
+		return disc, nil
+	}
+	majority, err := toc.Disc()
+	if err != nil {
+		disc.Close()
+		return Disc{}, err
+	}
+	result := withMetadata(majority.handle, disc, 0)
+	disc.Close()
+	return result, nil
+}
+
+// Select the value read by more than half of the reads for each field of
+// the TOC.
+func majorityToc(tocs []Toc) (Toc, error) {
+	majority := func(field string, values []int) (int, error) {
+		counts := make(map[int]int)
+		for _, v := range values {
+			counts[v]++
+			if counts[v]*2 > len(tocs) {
+				return v, nil
+			}
+		}
+		return 0, fmt.Errorf("%w: no majority for %v in %v reads, read %v",
+			ErrTocNotConverged, field, len(tocs), values)
+	}
+	collect := func(value func(t Toc) (int, bool)) []int {
+		values := []int{}
+		for _, t := range tocs {
+			if v, ok := value(t); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+
+	result := Toc{}
+	var err error
+	if result.First, err = majority("the first track", collect(func(t Toc) (int, bool) {
+		return t.First, true
+	})); err != nil {
+		return Toc{}, err
+	}
+	if result.Last, err = majority("the last track", collect(func(t Toc) (int, bool) {
+		return t.Last, true
+	})); err != nil {
+		return Toc{}, err
+	}
+	if result.Leadout, err = majority("the leadout", collect(func(t Toc) (int, bool) {
+		return t.Leadout, true
+	})); err != nil {
+		return Toc{}, err
+	}
+	for n := result.First; n <= result.Last; n++ {
+		offset, err := majority(fmt.Sprintf("track %v", n), collect(func(t Toc) (int, bool) {
+			i := n - t.First
+			if i < 0 || i >= len(t.TrackOffsets) {
+				return 0, false
+			}
+			return t.TrackOffsets[i], true
+		}))
+		if err != nil {
+			return Toc{}, err
+		}
+		result.TrackOffsets = append(result.TrackOffsets, offset)
+	}
+	return result, nil
+}
+
+// For each track select the ISRC read most often. On a tie non-empty ISRCs
+// are preferred, otherwise the ISRC read first wins.
+func majorityIsrcs(reads [][]Track) map[int]string {
+	isrcs := make(map[int]string)
+	for i, track := range reads[0] {
+		counts := make(map[string]int)
+		best := ""
+		for _, tracks := range reads {
+			isrc := tracks[i].Isrc
+			counts[isrc]++
+			if counts[isrc] > counts[best] || (counts[isrc] == counts[best] && best == "") {
+				best = isrc
+			}
+		}
+		isrcs[track.Number] = best
+	}
+	return isrcs
+}
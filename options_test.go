@@ -0,0 +1,178 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMajorityIsrcs(t *testing.T) {
+	reads := [][]Track{
+		{{Number: 1, Isrc: "DEA123400001"}, {Number: 2, Isrc: ""}, {Number: 3, Isrc: "DEA123400003"}},
+		{{Number: 1, Isrc: "DEA123400001"}, {Number: 2, Isrc: "DEA123400002"}, {Number: 3, Isrc: "DEA123400007"}},
+		{{Number: 1, Isrc: "DEA1234000X1"}, {Number: 2, Isrc: ""}, {Number: 3, Isrc: "DEA123400003"}},
+	}
+	assert.Equal(t, map[int]string{
+		1: "DEA123400001",
+		2: "",
+		3: "DEA123400003",
+	}, majorityIsrcs(reads))
+}
+
+func TestMajorityIsrcsTie(t *testing.T) {
+	reads := [][]Track{
+		{{Number: 1, Isrc: ""}, {Number: 2, Isrc: "DEA123400002"}},
+		{{Number: 1, Isrc: "DEA123400001"}, {Number: 2, Isrc: "DEA123400009"}},
+	}
+	assert.Equal(t, map[int]string{
+		1: "DEA123400001",
+		2: "DEA123400002",
+	}, majorityIsrcs(reads))
+}
+
+func TestReadWithOptionsInvalidDevice(t *testing.T) {
+	_, err := ReadWithOptions("notadevice", ReadOptions{Features: FeatureIsrc, IsrcReads: 3})
+	assert.Error(t, err)
+}
+
+func TestCheckTocReads(t *testing.T) {
+	tocs := []string{"1 2 34567 150 10000", "1 2 34567 150 10000", "1 2 34570 150 10000"}
+	reads := 0
+	read := func() (string, error) {
+		reads++
+		return tocs[reads], nil
+	}
+	assert.NoError(t, checkTocReads(tocs[0], 2, read))
+	assert.Equal(t, 1, reads)
+
+	reads = 0
+	err := checkTocReads(tocs[0], 3, read)
+	assert.True(t, errors.Is(err, ErrInconsistentToc))
+	assert.Equal(t, 2, reads)
+}
+
+func TestCheckTocReadsError(t *testing.T) {
+	failure := errors.New("no disc")
+	err := checkTocReads("1 1 10000 150", 2, func() (string, error) { return "", failure })
+	assert.Equal(t, failure, err)
+}
+
+func TestMajorityToc(t *testing.T) {
+	assert := assert.New(t)
+	parse := func(s string) Toc {
+		toc, err := ParseToc(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return toc
+	}
+	toc, err := majorityToc([]Toc{
+		parse("1 2 34567 150 10000"),
+		parse("1 2 34570 150 10000"),
+		parse("1 2 34567 150 10002"),
+	})
+	assert.NoError(err)
+	assert.Equal("1 2 34567 150 10000", toc.String())
+
+	_, err = majorityToc([]Toc{
+		parse("1 2 34567 150 10000"),
+		parse("1 2 34570 150 10000"),
+	})
+	assert.True(errors.Is(err, ErrTocNotConverged))
+	assert.EqualError(err, "TOC reads did not converge: no majority for the leadout in 2 reads, read [34567 34570]")
+
+	_, err = majorityToc([]Toc{
+		parse("1 2 34567 150 10000"),
+		parse("1 2 34567 150 10001"),
+		parse("1 2 34567 150 10002"),
+	})
+	assert.EqualError(err, "TOC reads did not converge: no majority for track 2 in 3 reads, read [10000 10001 10002]")
+}
+
+func TestMajorityTocReads(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := ParseCueSheet(strings.NewReader(`CATALOG 4006381333931
+FILE "disc.wav" WAVE
+  TRACK 01 AUDIO
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    ISRC DEA123400002
+    INDEX 01 02:13:25
+`), 34420)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tocs := []string{"1 2 34567 150 10002", "1 2 34567 150 10002"}
+	disc, err = majorityTocReads(disc, 3, func() (Toc, error) {
+		toc, err := ParseToc(tocs[0])
+		tocs = tocs[1:]
+		return toc, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal("1 2 34567 150 10002", disc.TocString())
+	assert.Equal("4006381333931", disc.Mcn())
+	assert.Equal("DEA123400002", disc.Track(2).Isrc)
+}
+
+func TestRetryNotReady(t *testing.T) {
+	notReady := fmt.Errorf("%w: cannot read TOC", ErrNotReady)
+	sleeps := []time.Duration{}
+	sleep := func(d time.Duration) { sleeps = append(sleeps, d) }
+	reads := 0
+	_, err := retryNotReady(3*time.Second, sleep, func() (Disc, error) {
+		reads++
+		if reads < 4 {
+			return Disc{}, notReady
+		}
+		return Disc{}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, reads)
+	assert.Equal(t, []time.Duration{250 * time.Millisecond, 500 * time.Millisecond, time.Second}, sleeps)
+}
+
+func TestRetryNotReadyTimeout(t *testing.T) {
+	notReady := fmt.Errorf("%w: cannot read TOC", ErrNotReady)
+	sleeps := []time.Duration{}
+	sleep := func(d time.Duration) { sleeps = append(sleeps, d) }
+	_, err := retryNotReady(6*time.Second, sleep, func() (Disc, error) { return Disc{}, notReady })
+	assert.Equal(t, notReady, err)
+	assert.Equal(t, []time.Duration{
+		250 * time.Millisecond, 500 * time.Millisecond, time.Second,
+		2 * time.Second, 2 * time.Second, 250 * time.Millisecond,
+	}, sleeps)
+}
+
+func TestRetryNotReadyOtherError(t *testing.T) {
+	noDisc := fmt.Errorf("%w: cannot read TOC", ErrNoDisc)
+	reads := 0
+	_, err := retryNotReady(time.Second, func(time.Duration) { t.Fatal("unexpected sleep") },
+		func() (Disc, error) {
+			reads++
+			return Disc{}, noDisc
+		})
+	assert.Equal(t, noDisc, err)
+	assert.Equal(t, 1, reads)
+}
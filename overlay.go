@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Wraps the handle of a backend adding data the backend cannot provide
+// itself, e.g. ISRCs from repeated reads or data parsed from a cue sheet.
+// For unset values the wrapped handle is used.
+type overlayHandle struct {
+	handle
+	mcnStr  string
+	isrcs   map[int]string
+	indexes map[int][]int
+	data    map[int]bool
+}
+
+func (h overlayHandle) mcn() string {
+	if h.mcnStr != "" {
+		return h.mcnStr
+	}
+	return h.handle.mcn()
+}
+
+func (h overlayHandle) trackIsrc(number int) string {
+	if isrc, ok := h.isrcs[number]; ok {
+		return isrc
+	}
+	return h.handle.trackIsrc(number)
+}
+
+func (h overlayHandle) trackIndexes(number int) []int {
+	if indexes, ok := h.indexes[number]; ok {
+		return indexes
+	}
+	return h.handle.trackIndexes(number)
+}
+
+func (h overlayHandle) trackIsData(number int) bool {
+	if data, ok := h.data[number]; ok {
+		return data
+	}
+	return h.handle.trackIsData(number)
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayHandle(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := Parse("1 2 34567 150 10000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	disc = Disc{overlayHandle{
+		handle:  disc.handle,
+		mcnStr:  "4006381333931",
+		isrcs:   map[int]string{2: "DEA123400002"},
+		indexes: map[int][]int{1: {5000}},
+	}}
+	assert.Equal("4006381333931", disc.Mcn())
+	assert.Equal("", disc.Track(1).Isrc)
+	assert.Equal([]int{5000}, disc.Track(1).Indexes)
+	assert.Equal("DEA123400002", disc.Track(2).Isrc)
+	assert.Nil(disc.Track(2).Indexes)
+	assert.Equal(10000, disc.Track(2).Offset)
+}
+
+func TestOverlayHandleData(t *testing.T) {
+	disc, err := Parse("1 2 34567 150 10000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	disc = Disc{overlayHandle{handle: disc.handle, data: map[int]bool{2: true}}}
+	assert.False(t, disc.Track(1).Data)
+	assert.True(t, disc.Track(2).Data)
+}
+
+func TestOverlayHandleDataIsrc(t *testing.T) {
+	disc, err := Parse("1 2 34567 150 10000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	disc = Disc{overlayHandle{
+		handle: disc.handle,
+		isrcs:  map[int]string{1: "DEA123400001", 2: "000000000000"},
+		data:   map[int]bool{2: true},
+	}}
+	assert.Equal(t, "DEA123400001", disc.Track(1).Isrc)
+	assert.Equal(t, "", disc.Track(2).Isrc)
+}
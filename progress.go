@@ -0,0 +1,57 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Progress of a read with discid.ReadWithOptions
+//
+// A read consists of one step for the TOC, one step for the MCN and one
+// step for the ISRC of each track, depending on the requested features.
+// libdiscid reads the ISRCs of all tracks at once, hence the ISRC steps
+// complete together.
+type ReadProgress struct {
+	// The number of completed steps
+	Completed int
+	// The estimated total number of steps. Before the TOC has been read the
+	// number of tracks is unknown and a typical track count is assumed.
+	Total int
+}
+
+// Return the completion as a fraction between 0 and 1.
+func (p ReadProgress) Fraction() float64 {
+	if p.Total <= 0 {
+		return 0
+	}
+	return float64(p.Completed) / float64(p.Total)
+}
+
+// The track count assumed for the progress before the TOC has been read
+const estimatedTrackCount = 12
+
+// Return the number of steps for reading a disc with the given features and
+// number of tracks. If tracks is zero estimatedTrackCount is assumed.
+func estimateSteps(features Feature, tracks int) int {
+	if tracks <= 0 {
+		tracks = estimatedTrackCount
+	}
+	steps := 1
+	if features&FeatureMcn != 0 {
+		steps++
+	}
+	if features&FeatureIsrc != 0 {
+		steps += tracks
+	}
+	return steps
+}
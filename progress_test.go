@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateSteps(t *testing.T) {
+	assert.Equal(t, 1, estimateSteps(FeatureRead, 10))
+	assert.Equal(t, 2, estimateSteps(FeatureMcn, 10))
+	assert.Equal(t, 12, estimateSteps(FeatureAll, 10))
+	assert.Equal(t, 1+1+estimatedTrackCount, estimateSteps(FeatureAll, 0))
+}
+
+func TestReadProgressFraction(t *testing.T) {
+	assert.Equal(t, 0.5, ReadProgress{Completed: 2, Total: 4}.Fraction())
+	assert.Equal(t, 0.0, ReadProgress{}.Fraction())
+}
+
+func TestReadPhasesProgress(t *testing.T) {
+	progress := []ReadProgress{}
+	opts := ReadOptions{
+		Features: FeatureAll,
+		Progress: func(p ReadProgress) { progress = append(progress, p) },
+	}
+	disc, err := readPhases("", opts, fakePhaseRead(t, "1 2 34567 150 10000", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, []ReadProgress{
+		{Completed: 0, Total: 14},
+		{Completed: 1, Total: 4},
+		{Completed: 2, Total: 4},
+		{Completed: 4, Total: 4},
+	}, progress)
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The maximum disc length in sectors accepted by libdiscid (90 minutes).
+const maxDiscLength = 90 * 60 * SectorsPerSecond
+
+// Disc data calculated in Go without libdiscid.
+//
+// The disc ID calculation follows the MusicBrainz disc ID algorithm
+// (https://musicbrainz.org/doc/Disc_ID_Calculation).
+type goHandle struct {
+	first   int
+	last    int
+	offsets [100]int
+	mcnStr  string
+	isrcs   [100]string
+}
+
+func newGoHandle(first int, last int, offsets *[100]int) (*goHandle, error) {
+	if err := validateToc(first, last, offsets); err != nil {
+		return nil, err
+	}
+	return &goHandle{first: first, last: last, offsets: *offsets}, nil
+}
+
+// Perform the same checks with the same error messages as libdiscid's
+// discid_put.
+func validateToc(first int, last int, offsets *[100]int) error {
+	if first > last || first < 1 || first > 99 || last < 1 || last > 99 {
+		return errors.New("Illegal track limits")
+	}
+	if offsets[0] > maxDiscLength {
+		return errors.New("Disc too long")
+	}
+	for i := first; i <= last; i++ {
+		if offsets[i] > offsets[0] {
+			return errors.New("Invalid offset")
+		}
+		if i > first && offsets[i-1] > offsets[i] {
+			return errors.New("Invalid order")
+		}
+	}
+	return nil
+}
+
+// Calculate the MusicBrainz disc ID.
+//
+// offsets[0] is the leadout, offsets[n] the offset of track n.
+func calculateId(first int, last int, offsets *[100]int) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "%02X%02X", first, last)
+	for _, offset := range offsets {
+		fmt.Fprintf(h, "%08X", offset)
+	}
+	id := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return strings.NewReplacer("+", ".", "/", "_", "=", "-").Replace(id)
+}
+
+// Build the URL for submitting the disc ID to MusicBrainz in the same format
+// as libdiscid.
+func buildSubmissionUrl(id string, toc string, last int) string {
+	return fmt.Sprintf("http://musicbrainz.org/cdtoc/attach?id=%s&tracks=%d&toc=%s",
+		id, last, tocParam(toc))
+}
+
+func (h *goHandle) free() {}
+
+func (h *goHandle) errorMessage() string {
+	return ""
+}
+
+func (h *goHandle) id() string {
+	return calculateId(h.first, h.last, &h.offsets)
+}
+
+func (h *goHandle) freedbId() string {
+	return calculateFreedbId(h.first, h.last, &h.offsets)
+}
+
+func (h *goHandle) tocString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %d %d", h.first, h.last, h.offsets[0])
+	for i := h.first; i <= h.last; i++ {
+		fmt.Fprintf(&b, " %d", h.offsets[i])
+	}
+	return b.String()
+}
+
+func (h *goHandle) submissionUrl() string {
+	return buildSubmissionUrl(h.id(), h.tocString(), h.last)
+}
+
+func (h *goHandle) firstTrackNum() int {
+	return h.first
+}
+
+func (h *goHandle) lastTrackNum() int {
+	return h.last
+}
+
+func (h *goHandle) sectors() int {
+	return h.offsets[0]
+}
+
+func (h *goHandle) mcn() string {
+	return h.mcnStr
+}
+
+func (h *goHandle) trackOffset(number int) int {
+	if number < h.first || number > h.last {
+		return 0
+	}
+	return h.offsets[number]
+}
+
+func (h *goHandle) trackLength(number int) int {
+	if number < h.first || number > h.last {
+		return 0
+	}
+	if number == h.last {
+		return h.offsets[0] - h.offsets[number]
+	}
+	return h.offsets[number+1] - h.offsets[number]
+}
+
+func (h *goHandle) trackIsrc(number int) string {
+	if number < h.first || number > h.last {
+		return ""
+	}
+	return h.isrcs[number]
+}
+
+func (h *goHandle) trackIndexes(number int) []int {
+	return nil
+}
+
+func (h *goHandle) trackIsData(number int) bool {
+	return false
+}
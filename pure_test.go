@@ -0,0 +1,75 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoHandle(t *testing.T) {
+	assert := assert.New(t)
+	offsets := [100]int{
+		242457, 150, 44942, 61305, 72755, 96360, 130485, 147315, 164275, 190702, 205412, 220437,
+	}
+	h, err := newGoHandle(1, 11, &offsets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("lSOVc5h6IXSuzcamJS1Gp4_tRuA-", h.id())
+	assert.Equal(
+		"1 11 242457 150 44942 61305 72755 96360 130485 147315 164275 190702 205412 220437",
+		h.tocString())
+	assert.Equal(
+		"http://musicbrainz.org/cdtoc/attach?id=lSOVc5h6IXSuzcamJS1Gp4_tRuA-&tracks=11&toc=1+11+242457+150+44942+61305+72755+96360+130485+147315+164275+190702+205412+220437",
+		h.submissionUrl())
+	assert.Equal(1, h.firstTrackNum())
+	assert.Equal(11, h.lastTrackNum())
+	assert.Equal(242457, h.sectors())
+	assert.Equal(150, h.trackOffset(1))
+	assert.Equal(44792, h.trackLength(1))
+	assert.Equal(22020, h.trackLength(11))
+	assert.Equal(0, h.trackLength(12))
+}
+
+func TestGoHandleFirstTrackLargerOne(t *testing.T) {
+	offsets := [100]int{
+		206535, 0, 0, 150, 18901, 39738, 59557, 79152, 100126, 124833, 147278, 166336, 182560,
+	}
+	h, err := newGoHandle(3, 12, &offsets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "ByBKvJM1hBL7XtvsPyYtIjlX0Bw-", h.id())
+}
+
+func TestGoHandleInvalid(t *testing.T) {
+	assert := assert.New(t)
+	offsets := [100]int{1000, 150, 500}
+	_, err := newGoHandle(1, 100, &offsets)
+	assert.EqualError(err, "Illegal track limits")
+	_, err = newGoHandle(2, 1, &offsets)
+	assert.EqualError(err, "Illegal track limits")
+	_, err = newGoHandle(1, 3, &offsets)
+	assert.EqualError(err, "Invalid order")
+	offsets[2] = 2000
+	_, err = newGoHandle(1, 2, &offsets)
+	assert.EqualError(err, "Invalid offset")
+	offsets[0] = maxDiscLength + 1
+	_, err = newGoHandle(1, 2, &offsets)
+	assert.EqualError(err, "Disc too long")
+}
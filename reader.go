@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "context"
+
+// Reads discs from disc drives.
+//
+// DiscReader allows applications to abstract from where the disc drive is
+// located. Use discid.LocalReader for the drives of the local machine.
+type DiscReader interface {
+	// Read the disc in the given device with the given features.
+	//
+	// See discid.ReadFeatures for details.
+	ReadDisc(ctx context.Context, device string, features Feature) (Snapshot, error)
+	// Return the names of all available disc drives.
+	ListDevices(ctx context.Context) ([]string, error)
+}
+
+// DiscReader implementation for the disc drives of the local machine.
+type LocalReader struct{}
+
+// Read the disc in the given local device.
+//
+// If the context gets cancelled ReadDisc returns early, see
+// discid.ReadContext for which reads get aborted.
+func (LocalReader) ReadDisc(ctx context.Context, device string, features Feature) (Snapshot, error) {
+	disc, err := ReadContext(ctx, device, features)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer disc.Close()
+	return disc.Snapshot(), nil
+}
+
+// Return the local disc drives as returned by discid.ListDevices.
+func (LocalReader) ListDevices(ctx context.Context) ([]string, error) {
+	return ListDevices(), nil
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestLocalReaderInvalidDevice(t *testing.T) {
+	var reader discid.DiscReader = discid.LocalReader{}
+	_, err := reader.ReadDisc(context.Background(), "notadevice", discid.FeatureRead)
+	assert.Error(t, err)
+}
+
+func TestLocalReaderCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := discid.LocalReader{}.ReadDisc(ctx, "", discid.FeatureRead)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func ExampleDiscReader() {
+	var reader discid.DiscReader = discid.LocalReader{}
+	disc, err := reader.ReadDisc(context.Background(), "", discid.FeatureMcn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Disc ID: %v\n", disc.Id)
+	fmt.Printf("MCN    : %v\n", disc.Mcn)
+}
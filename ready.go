@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Wait until the drive in the given device contains a readable disc.
+//
+// The drive is polled every DefaultWatchInterval until its TOC can be read
+// or the context gets cancelled, in which case the error of the context is
+// returned. Unlike discid.WaitForDisc the disc is not returned, which
+// allows applications to e.g. prompt the operator before reading the disc
+// with the desired features. Errors which waiting cannot resolve, e.g.
+// discid.ErrNoDrive or discid.ErrPermission, are returned immediately.
+func WaitForDiscReady(ctx context.Context, device string) error {
+	statusDevice := resolveDeviceName(device)
+	if statusDevice == "" {
+		statusDevice = defaultDevice()
+	}
+	return waitForDiscReady(ctx, DefaultWatchInterval,
+		func() driveStatus { return readDriveStatus(statusDevice) },
+		func() error {
+			disc, err := Read(device)
+			if err == nil {
+				disc.Close()
+			}
+			return err
+		})
+}
+
+func waitForDiscReady(ctx context.Context, interval time.Duration, status func() driveStatus, read func() error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// Only try reading if the drive reports a disc or cannot report
+		// its status at all.
+		if s := status(); s != statusNoDisc && s != statusNotReady {
+			err := read()
+			if err == nil || errors.Is(err, ErrNotSupported) || errors.Is(err, ErrNoDrive) ||
+				errors.Is(err, ErrPermission) {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForDiscReady(t *testing.T) {
+	statuses := []driveStatus{statusNoDisc, statusNotReady, statusDiscOk, statusDiscOk}
+	reads := 0
+	err := waitForDiscReady(context.Background(), time.Millisecond,
+		func() driveStatus {
+			s := statuses[0]
+			statuses = statuses[1:]
+			return s
+		},
+		func() error {
+			reads++
+			if reads == 1 {
+				return errors.New("cannot read TOC")
+			}
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reads)
+	assert.Empty(t, statuses)
+}
+
+func TestWaitForDiscReadyPermanentError(t *testing.T) {
+	err := waitForDiscReady(context.Background(), time.Millisecond,
+		func() driveStatus { return statusUnknown },
+		func() error { return ErrPermission })
+	assert.Equal(t, ErrPermission, err)
+}
+
+func TestWaitForDiscReadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := waitForDiscReady(ctx, time.Millisecond,
+		func() driveStatus { return statusNoDisc },
+		func() error { return nil })
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package remote
+
+import (
+	"context"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Client for a remote disc service.
+//
+// Client implements discid.DiscReader, so it can be used as a drop-in
+// replacement for discid.LocalReader.
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+// Connect to the disc service at the given target, e.g. "cdserver:5100".
+//
+// Without any options the connection is established without transport
+// security.
+func Dial(target string, opts ...grpc.DialOption) (*Client, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+// Create a client using an existing connection.
+func NewClient(conn *grpc.ClientConn) *Client {
+	return &Client{conn}
+}
+
+// Close the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Read the disc in the given device of the server.
+//
+// If device is empty the server's default device is used.
+func (c *Client) ReadDisc(ctx context.Context, device string, features discid.Feature) (discid.Snapshot, error) {
+	req := &ReadDiscRequest{Device: device, Features: features}
+	resp := new(discid.Snapshot)
+	err := c.conn.Invoke(ctx, "/"+ServiceName+"/ReadDisc", req, resp, c.callOptions()...)
+	return *resp, err
+}
+
+// Return the names of the disc drives available on the server.
+func (c *Client) ListDevices(ctx context.Context) ([]string, error) {
+	resp := new(ListDevicesResponse)
+	err := c.conn.Invoke(ctx, "/"+ServiceName+"/ListDevices", &ListDevicesRequest{}, resp, c.callOptions()...)
+	return resp.Devices, err
+}
+
+// Return the default device of the server.
+func (c *Client) DefaultDevice(ctx context.Context) (string, error) {
+	resp := new(ListDevicesResponse)
+	err := c.conn.Invoke(ctx, "/"+ServiceName+"/ListDevices", &ListDevicesRequest{}, resp, c.callOptions()...)
+	return resp.DefaultDevice, err
+}
+
+// Stream of media change events received from the server
+type MediaEventStream struct {
+	stream grpc.ClientStream
+}
+
+// Wait for the next media change event.
+//
+// Returns io.EOF once the server has ended the stream.
+func (s *MediaEventStream) Recv() (MediaEvent, error) {
+	e := MediaEvent{}
+	err := s.stream.RecvMsg(&e)
+	return e, err
+}
+
+// Watch the given device of the server for media changes.
+//
+// Events are sent until the context is cancelled. If interval is zero the
+// server's default polling interval is used.
+func (c *Client) WatchMedia(ctx context.Context, device string, features discid.Feature, interval time.Duration) (*MediaEventStream, error) {
+	desc := &serviceDesc.Streams[0]
+	stream, err := c.conn.NewStream(ctx, desc, "/"+ServiceName+"/WatchMedia", c.callOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	req := &WatchMediaRequest{
+		Device:     device,
+		Features:   features,
+		IntervalMs: int64(interval / time.Millisecond),
+	}
+	if err := stream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return &MediaEventStream{stream}, nil
+}
+
+func (c *Client) callOptions() []grpc.CallOption {
+	return []grpc.CallOption{CallCodec()}
+}
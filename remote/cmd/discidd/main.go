@@ -0,0 +1,44 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// discidd serves the disc drives of this machine via gRPC.
+//
+// Usage:
+//
+//	discidd [-listen address]
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/phw/go-discid/remote"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	address := flag.String("listen", ":5100", "address to listen on")
+	flag.Parse()
+
+	listener, err := net.Listen("tcp", *address)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := grpc.NewServer(remote.ServerCodec())
+	remote.RegisterDiscServiceServer(s, remote.NewServer())
+	log.Printf("Serving disc drives on %v", listener.Addr())
+	log.Fatal(s.Serve(listener))
+}
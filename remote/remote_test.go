@@ -0,0 +1,81 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package remote_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/remote"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func dialTestServer(t *testing.T) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(remote.ServerCodec())
+	remote.RegisterDiscServiceServer(s, remote.NewServer())
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestListDevices(t *testing.T) {
+	client := remote.NewClient(dialTestServer(t))
+	device, err := client.DefaultDevice(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, discid.DefaultDevice(), device)
+	}
+	devices, err := client.ListDevices(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, discid.ListDevices(), devices)
+	}
+}
+
+func TestReadDiscInvalidDevice(t *testing.T) {
+	var reader discid.DiscReader = remote.NewClient(dialTestServer(t))
+	_, err := reader.ReadDisc(context.Background(), "notadevice", discid.FeatureRead)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestWatchMediaCancel(t *testing.T) {
+	client := remote.NewClient(dialTestServer(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.WatchMedia(ctx, "notadevice", discid.FeatureRead, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
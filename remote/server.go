@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package remote
+
+import (
+	"context"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Implementation of DiscServiceServer serving the local disc drives.
+type Server struct{}
+
+// Create a new server for the local disc drives.
+func NewServer() *Server {
+	return &Server{}
+}
+
+func (s *Server) ReadDisc(ctx context.Context, req *ReadDiscRequest) (*discid.Snapshot, error) {
+	disc, err := discid.ReadContext(ctx, req.Device, req.Features)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	defer disc.Close()
+	snapshot := disc.Snapshot()
+	return &snapshot, nil
+}
+
+func (s *Server) ListDevices(ctx context.Context, req *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return &ListDevicesResponse{
+		Devices:       discid.ListDevices(),
+		DefaultDevice: discid.DefaultDevice(),
+	}, nil
+}
+
+func (s *Server) WatchMedia(req *WatchMediaRequest, stream DiscService_WatchMediaServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	var sendErr error
+	send := func(e *MediaEvent) {
+		if sendErr == nil {
+			if sendErr = stream.Send(e); sendErr != nil {
+				cancel()
+			}
+		}
+	}
+	w := discid.Watcher{
+		Device:   req.Device,
+		Features: req.Features,
+		Interval: time.Duration(req.IntervalMs) * time.Millisecond,
+		OnInsert: func(s discid.Snapshot) {
+			send(&MediaEvent{Type: MediaInserted, Disc: &s})
+		},
+		OnRemove: func() {
+			send(&MediaEvent{Type: MediaRemoved})
+		},
+	}
+	err := w.Run(ctx)
+	if sendErr != nil {
+		return sendErr
+	}
+	return status.FromContextError(err).Err()
+}
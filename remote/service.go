@@ -0,0 +1,207 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// remote serves disc data from a local disc drive to remote clients via gRPC.
+//
+// The service offers reading discs, listing the available drives and a
+// stream of media change events. Messages are encoded as JSON using the same
+// structure as discid.Snapshot, hence no protobuf code generation is needed
+// for Go. The service and its messages are described in discid.proto for
+// clients in other languages.
+//
+// Use NewServer and RegisterDiscServiceServer to serve the drives of the
+// local machine:
+//
+//	s := grpc.NewServer(remote.ServerCodec())
+//	remote.RegisterDiscServiceServer(s, remote.NewServer())
+//	s.Serve(listener)
+//
+// On the client side Dial returns a Client implementing discid.DiscReader,
+// so switching from the local drive to a remote one is a single line:
+//
+//	var reader discid.DiscReader = discid.LocalReader{}
+//	// becomes
+//	reader, err := remote.Dial("cdserver:5100")
+package remote
+
+import (
+	"context"
+	"encoding/json"
+
+	discid "github.com/phw/go-discid"
+	"google.golang.org/grpc"
+)
+
+// The full name of the gRPC service
+const ServiceName = "discid.DiscService"
+
+// Name of the codec used for encoding the messages
+const CodecName = "json"
+
+// Return the server option making a gRPC server encode all messages as
+// JSON, which is required for serving the disc service.
+//
+// The codec is not registered globally with encoding.RegisterCodec, hence
+// other codecs named "json" in the same process are not replaced.
+func ServerCodec() grpc.ServerOption {
+	return grpc.ForceServerCodec(jsonCodec{})
+}
+
+// Return the call option making a call encode its messages as JSON.
+//
+// Client sets it for all calls, it is only needed for calling the disc
+// service on a plain grpc.ClientConn.
+func CallCodec() grpc.CallOption {
+	return grpc.ForceCodec(jsonCodec{})
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+// Request for DiscService.ReadDisc
+type ReadDiscRequest struct {
+	// The device to read from. If empty the server's default device is used.
+	Device string `json:"device,omitempty"`
+	// The features to read, see discid.ReadFeatures
+	Features discid.Feature `json:"features,omitempty"`
+}
+
+// Request for DiscService.ListDevices
+type ListDevicesRequest struct{}
+
+// Response of DiscService.ListDevices
+type ListDevicesResponse struct {
+	// All disc drives found on the server
+	Devices []string `json:"devices"`
+	// The server's default device
+	DefaultDevice string `json:"default_device"`
+}
+
+// Request for DiscService.WatchMedia
+type WatchMediaRequest struct {
+	// The device to watch. If empty the server's default device is used.
+	Device string `json:"device,omitempty"`
+	// The features to read for newly inserted discs
+	Features discid.Feature `json:"features,omitempty"`
+	// The polling interval in milliseconds. If zero the default is used.
+	IntervalMs int64 `json:"interval_ms,omitempty"`
+}
+
+// Type of a media change event
+type MediaEventType string
+
+const (
+	// A new disc has been inserted
+	MediaInserted MediaEventType = "inserted"
+	// The disc has been removed
+	MediaRemoved MediaEventType = "removed"
+)
+
+// Event sent by DiscService.WatchMedia
+type MediaEvent struct {
+	Type MediaEventType `json:"type"`
+	// The inserted disc. Only set for MediaInserted.
+	Disc *discid.Snapshot `json:"disc,omitempty"`
+}
+
+// Server API for the disc service
+type DiscServiceServer interface {
+	// Read the disc in the given drive.
+	ReadDisc(ctx context.Context, req *ReadDiscRequest) (*discid.Snapshot, error)
+	// List the disc drives available on the server.
+	ListDevices(ctx context.Context, req *ListDevicesRequest) (*ListDevicesResponse, error)
+	// Stream media change events for a drive until the client cancels.
+	WatchMedia(req *WatchMediaRequest, stream DiscService_WatchMediaServer) error
+}
+
+// Server stream of DiscService.WatchMedia
+type DiscService_WatchMediaServer interface {
+	Send(*MediaEvent) error
+	grpc.ServerStream
+}
+
+type watchMediaServer struct {
+	grpc.ServerStream
+}
+
+func (s *watchMediaServer) Send(e *MediaEvent) error {
+	return s.ServerStream.SendMsg(e)
+}
+
+// Register the disc service implementation srv with the gRPC server s.
+func RegisterDiscServiceServer(s grpc.ServiceRegistrar, srv DiscServiceServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DiscServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "ReadDisc", Handler: readDiscHandler},
+		{MethodName: "ListDevices", Handler: listDevicesHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "WatchMedia", Handler: watchMediaHandler, ServerStreams: true},
+	},
+}
+
+func readDiscHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(ReadDiscRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscServiceServer).ReadDisc(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + ServiceName + "/ReadDisc"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscServiceServer).ReadDisc(ctx, req.(*ReadDiscRequest))
+	}
+	return interceptor(ctx, req, info, handler)
+}
+
+func listDevicesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(ListDevicesRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscServiceServer).ListDevices(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + ServiceName + "/ListDevices"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, req, info, handler)
+}
+
+func watchMediaHandler(srv interface{}, stream grpc.ServerStream) error {
+	req := new(WatchMediaRequest)
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+	return srv.(DiscServiceServer).WatchMedia(req, &watchMediaServer{stream})
+}
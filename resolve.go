@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// A device name together with the device node it refers to
+type DevicePath struct {
+	// The device name as given, e.g. "/dev/cdrom"
+	Name string
+	// The concrete device node, e.g. "/dev/sr0"
+	Node string
+	// Other names referring to the same device node, e.g.
+	// "/dev/disk/by-id/usb-HL-DT-ST_DVDRAM_GP57EB40-0:0"
+	Aliases []string
+}
+
+// Resolve a device name to the concrete device node.
+//
+// On Linux symbolic links like "/dev/cdrom" or the links in /dev/disk/by-id
+// and /dev/disk/by-path are resolved to the device node, e.g. "/dev/sr0",
+// and all other links referring to the same node are returned as aliases.
+// This allows keying logs and caches on the device node, while users can
+// pass any of the names. On other platforms the node is the given name.
+//
+// The name is normalized with discid.NormalizeDevice first. If name is
+// empty the default device is resolved.
+func ResolveDevice(name string) (DevicePath, error) {
+	name = NormalizeDevice(name)
+	if name == "" {
+		name = DefaultDevice()
+	}
+	return resolveDevice(name)
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"path/filepath"
+	"sort"
+)
+
+// Patterns for symbolic links referring to disc drives
+var deviceAliasPatterns = []string{
+	"/dev/cdrom*",
+	"/dev/cdrw*",
+	"/dev/dvd*",
+	"/dev/dvdrw*",
+	"/dev/disk/by-id/*",
+	"/dev/disk/by-path/*",
+}
+
+func resolveDevice(name string) (DevicePath, error) {
+	return resolveDeviceLinks(name, deviceAliasPatterns)
+}
+
+func resolveDeviceLinks(name string, patterns []string) (DevicePath, error) {
+	node, err := filepath.EvalSymlinks(name)
+	if err != nil {
+		return DevicePath{}, err
+	}
+	path := DevicePath{Name: name, Node: node, Aliases: []string{}}
+	for _, pattern := range patterns {
+		matches, _ := filepath.Glob(pattern)
+		for _, match := range matches {
+			if match == name || match == node {
+				continue
+			}
+			if target, err := filepath.EvalSymlinks(match); err == nil && target == node {
+				path.Aliases = append(path.Aliases, match)
+			}
+		}
+	}
+	sort.Strings(path.Aliases)
+	return path, nil
+}
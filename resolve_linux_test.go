@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveDeviceLinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := filepath.Join(dir, "sr0")
+	byId := filepath.Join(dir, "by-id")
+	if err := ioutil.WriteFile(node, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "sr1"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(byId, 0755); err != nil {
+		t.Fatal(err)
+	}
+	links := map[string]string{
+		filepath.Join(dir, "cdrom"):        "sr0",
+		filepath.Join(dir, "dvd"):          "sr0",
+		filepath.Join(dir, "cdrom1"):       "sr1",
+		filepath.Join(byId, "ata-DRIVE_1"): "../sr0",
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, link); err != nil {
+			t.Fatal(err)
+		}
+	}
+	patterns := []string{filepath.Join(dir, "cdrom*"), filepath.Join(dir, "dvd*"), filepath.Join(byId, "*")}
+
+	path, err := resolveDeviceLinks(filepath.Join(dir, "cdrom"), patterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DevicePath{
+		Name:    filepath.Join(dir, "cdrom"),
+		Node:    node,
+		Aliases: []string{filepath.Join(byId, "ata-DRIVE_1"), filepath.Join(dir, "dvd")},
+	}, path)
+
+	path, err = resolveDeviceLinks(node, patterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, node, path.Node)
+	assert.Len(t, path.Aliases, 3)
+}
+
+func TestResolveDeviceLinksMissing(t *testing.T) {
+	_, err := resolveDeviceLinks("/nonexistent/sr0", deviceAliasPatterns)
+	assert.Error(t, err)
+}
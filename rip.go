@@ -0,0 +1,95 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The maximum difference between the length of a ripped track and the
+// length according to the TOC accepted by VerifyRip.
+const RipTolerance = time.Second
+
+// A track whose ripped length differs from the TOC
+type RipMismatch struct {
+	// Track number
+	Track int
+	// The track length according to the TOC
+	Expected time.Duration
+	// The length of the ripped track
+	Actual time.Duration
+}
+
+// Returned by VerifyRip if the ripped tracks do not match the disc.
+type RipError struct {
+	// The number of audio tracks on the disc
+	ExpectedTracks int
+	// The number of ripped tracks
+	ActualTracks int
+	// Tracks with a length differing by more than RipTolerance
+	Mismatches []RipMismatch
+}
+
+func (e *RipError) Error() string {
+	if e.ExpectedTracks != e.ActualTracks {
+		return fmt.Sprintf("rip has %v tracks, disc has %v audio tracks",
+			e.ActualTracks, e.ExpectedTracks)
+	}
+	parts := make([]string, 0, len(e.Mismatches))
+	for _, m := range e.Mismatches {
+		parts = append(parts, fmt.Sprintf("track %v is %v, expected %v",
+			m.Track, m.Actual, m.Expected))
+	}
+	return "rip does not match disc: " + strings.Join(parts, ", ")
+}
+
+// Check ripped tracks against the TOC of the disc.
+//
+// trackDurations holds the lengths of the ripped files in track order. The
+// number of durations must match the number of audio tracks on the disc
+// and each duration must be within RipTolerance of the track length. Data
+// tracks are not expected to be ripped. Use this to detect truncated or
+// misnumbered rips.
+//
+// Returns a *RipError describing the differences if the rip does not match.
+func VerifyRip(disc Disc, trackDurations []time.Duration) error {
+	return verifyRip(disc.Snapshot().Tracks, trackDurations)
+}
+
+func verifyRip(tracks []Track, trackDurations []time.Duration) error {
+	audio := audioTracks(tracks)
+	ripErr := &RipError{ExpectedTracks: len(audio), ActualTracks: len(trackDurations)}
+	if len(audio) != len(trackDurations) {
+		return ripErr
+	}
+	for i, track := range audio {
+		expected := SectorsToDuration(track.Sectors)
+		diff := trackDurations[i] - expected
+		if diff < -RipTolerance || diff > RipTolerance {
+			ripErr.Mismatches = append(ripErr.Mismatches, RipMismatch{
+				Track:    track.Number,
+				Expected: expected,
+				Actual:   trackDurations[i],
+			})
+		}
+	}
+	if len(ripErr.Mismatches) > 0 {
+		return ripErr
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyRip(t *testing.T) {
+	disc, err := Parse("1 3 60000 150 15150 30150")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	durations := []time.Duration{
+		200 * time.Second,
+		200*time.Second + 500*time.Millisecond,
+		398 * time.Second,
+	}
+	assert.NoError(t, VerifyRip(disc, durations))
+
+	durations[2] = 300 * time.Second
+	err = VerifyRip(disc, durations)
+	if assert.IsType(t, &RipError{}, err) {
+		ripErr := err.(*RipError)
+		assert.Equal(t, []RipMismatch{{3, 398 * time.Second, 300 * time.Second}}, ripErr.Mismatches)
+		assert.EqualError(t, err, "rip does not match disc: track 3 is 5m0s, expected 6m38s")
+	}
+
+	err = VerifyRip(disc, durations[:2])
+	assert.EqualError(t, err, "rip has 2 tracks, disc has 3 audio tracks")
+}
+
+func TestVerifyRipDataTrack(t *testing.T) {
+	tracks := []Track{
+		{Number: 1, Offset: 150, Sectors: 15000},
+		{Number: 2, Offset: 15150, Sectors: 15000 + dataSessionGap},
+		{Number: 3, Offset: 30150 + dataSessionGap, Sectors: 20000, Data: true},
+	}
+	durations := []time.Duration{200 * time.Second, 200 * time.Second}
+	assert.NoError(t, verifyRip(tracks, durations))
+}
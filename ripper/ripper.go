@@ -0,0 +1,188 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// ripper orchestrates the workflow for identifying inserted discs.
+//
+// A Workflow waits for a disc, reads it, looks it up in the metadata
+// sources, writes a cue sheet and a rip manifest and optionally ejects the
+// disc. Hooks allow applications to act on the results of each step, e.g.
+// for starting the actual audio extraction.
+package ripper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+)
+
+// Callbacks for the steps of a Workflow. All hooks might be nil.
+//
+// If a hook returns an error the workflow stops and Workflow.Run returns
+// this error.
+type Hooks struct {
+	// Called after a disc was read from device.
+	DiscRead func(device string, disc discid.Snapshot) error
+	// Called after the disc was looked up. metadata.Release is nil if no
+	// release was found.
+	Identified func(metadata lookup.DiscMetadata) error
+	// Called after the cue sheet and the manifest were written.
+	FilesWritten func(result Result) error
+	// Called after the disc was ejected.
+	Ejected func(device string) error
+}
+
+// The identification workflow for discs.
+type Workflow struct {
+	// The drives to wait for a disc in. If empty all drives returned by
+	// discid.ListDevices are used, see discid.WaitForDisc.
+	Devices []string
+	// The features to read in addition to the TOC.
+	Features discid.Feature
+	// The interval for polling the drives, defaults to
+	// discid.DefaultWatchInterval.
+	Interval time.Duration
+	// The metadata sources, see lookup.IdentifySnapshot. If empty
+	// MusicBrainz with default settings is used.
+	Sources []lookup.Lookup
+	// The directory to write the cue sheet and the manifest to. The files
+	// are named after the disc ID. If empty no files are written.
+	OutputDir string
+	// The name of the audio file referenced by the cue sheet. Defaults to
+	// the disc ID with the extension ".flac".
+	AudioFile string
+	// Eject the disc at the end of the workflow.
+	Eject bool
+	Hooks Hooks
+}
+
+// The results of a workflow run
+type Result struct {
+	// The device the disc was read from
+	Device string
+	// The rip manifest of the disc
+	Manifest discid.Manifest
+	// The disc data combined with the metadata of the best matching release
+	Metadata lookup.DiscMetadata
+	// Paths of the written cue sheet and manifest, empty if
+	// Workflow.OutputDir is not set
+	CueSheetFile string
+	ManifestFile string
+}
+
+// Run the workflow for the next inserted disc.
+//
+// This blocks until a disc could be read or ctx gets cancelled. Not finding
+// the disc in any metadata source does not stop the workflow, the result
+// then only contains the data read from the disc.
+func (w *Workflow) Run(ctx context.Context) (Result, error) {
+	return w.run(ctx, discid.WaitForDisc, discid.Eject)
+}
+
+func (w *Workflow) run(ctx context.Context,
+	wait func(context.Context, []string, discid.Feature, time.Duration) (discid.Disc, string, error),
+	eject func(string) error) (Result, error) {
+	disc, device, err := wait(ctx, w.Devices, w.Features|discid.FeatureRead, w.Interval)
+	if err != nil {
+		return Result{}, err
+	}
+	readAt := time.Now()
+	snapshot := disc.Snapshot()
+	disc.Close()
+	result := Result{
+		Device:   device,
+		Manifest: discid.NewManifest(snapshot, device, readAt),
+	}
+	if w.Hooks.DiscRead != nil {
+		if err := w.Hooks.DiscRead(device, snapshot); err != nil {
+			return result, err
+		}
+	}
+
+	result.Metadata, err = lookup.IdentifySnapshot(ctx, snapshot, w.Sources...)
+	if err != nil && err != lookup.ErrNotFound {
+		return result, err
+	}
+	if w.Hooks.Identified != nil {
+		if err := w.Hooks.Identified(result.Metadata); err != nil {
+			return result, err
+		}
+	}
+
+	if w.OutputDir != "" {
+		if err := w.writeFiles(&result); err != nil {
+			return result, err
+		}
+		if w.Hooks.FilesWritten != nil {
+			if err := w.Hooks.FilesWritten(result); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if w.Eject {
+		if err := eject(device); err != nil {
+			return result, err
+		}
+		if w.Hooks.Ejected != nil {
+			if err := w.Hooks.Ejected(device); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Write the cue sheet and the manifest to the output directory.
+func (w *Workflow) writeFiles(result *Result) error {
+	disc := result.Manifest.Disc
+	audioFile := w.AudioFile
+	if audioFile == "" {
+		audioFile = disc.Id + ".flac"
+	}
+	cuePath := filepath.Join(w.OutputDir, disc.Id+".cue")
+	err := writeFile(cuePath, func(f *os.File) error {
+		return disc.WriteCueSheet(f, audioFile)
+	})
+	if err != nil {
+		return err
+	}
+	result.CueSheetFile = cuePath
+
+	manifestPath := filepath.Join(w.OutputDir, disc.Id+".json")
+	err = writeFile(manifestPath, func(f *os.File) error {
+		return result.Manifest.WriteJson(f)
+	})
+	if err != nil {
+		return err
+	}
+	result.ManifestFile = manifestPath
+	return nil
+}
+
+func writeFile(path string, write func(f *os.File) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
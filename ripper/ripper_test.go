@@ -0,0 +1,129 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ripper
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	discid "github.com/phw/go-discid"
+	"github.com/phw/go-discid/lookup"
+	"github.com/stretchr/testify/assert"
+)
+
+type staticLookup struct {
+	matches []lookup.ReleaseMatch
+}
+
+func (l staticLookup) Lookup(ctx context.Context, disc discid.Snapshot) ([]lookup.ReleaseMatch, error) {
+	return l.matches, nil
+}
+
+func fakeWait(ctx context.Context, devices []string, features discid.Feature, interval time.Duration) (discid.Disc, string, error) {
+	disc, err := discid.Parse("1 2 206535 150 18901")
+	return disc, "/dev/sr1", err
+}
+
+func TestWorkflowRun(t *testing.T) {
+	assert := assert.New(t)
+	dir, err := ioutil.TempDir("", "discid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	steps := []string{}
+	ejected := ""
+	w := Workflow{
+		Sources: []lookup.Lookup{staticLookup{matches: []lookup.ReleaseMatch{
+			{Id: "release", Title: "Album", Exact: true},
+		}}},
+		OutputDir: dir,
+		Eject:     true,
+		Hooks: Hooks{
+			DiscRead: func(device string, disc discid.Snapshot) error {
+				steps = append(steps, "read "+device)
+				return nil
+			},
+			Identified: func(metadata lookup.DiscMetadata) error {
+				steps = append(steps, "identified "+metadata.Release.Title)
+				return nil
+			},
+			FilesWritten: func(result Result) error {
+				steps = append(steps, "written")
+				return nil
+			},
+			Ejected: func(device string) error {
+				steps = append(steps, "ejected "+device)
+				return nil
+			},
+		},
+	}
+	result, err := w.run(context.Background(), fakeWait, func(device string) error {
+		ejected = device
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]string{"read /dev/sr1", "identified Album", "written", "ejected /dev/sr1"}, steps)
+	assert.Equal("/dev/sr1", ejected)
+	assert.Equal("/dev/sr1", result.Device)
+	assert.Equal("release", result.Metadata.Release.Id)
+
+	id := result.Manifest.Disc.Id
+	assert.Equal(filepath.Join(dir, id+".cue"), result.CueSheetFile)
+	assert.Equal(filepath.Join(dir, id+".json"), result.ManifestFile)
+	cue, err := ioutil.ReadFile(result.CueSheetFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(string(cue), `FILE "`+id+`.flac" WAVE`)
+	assert.FileExists(result.ManifestFile)
+}
+
+func TestWorkflowRunHookError(t *testing.T) {
+	hookErr := errors.New("stop")
+	w := Workflow{
+		Sources: []lookup.Lookup{staticLookup{}},
+		Eject:   true,
+		Hooks: Hooks{
+			Identified: func(metadata lookup.DiscMetadata) error {
+				assert.Nil(t, metadata.Release)
+				return hookErr
+			},
+		},
+	}
+	_, err := w.run(context.Background(), fakeWait, func(device string) error {
+		t.Fatal("disc must not be ejected")
+		return nil
+	})
+	assert.Equal(t, hookErr, err)
+}
+
+func TestWorkflowRunWaitError(t *testing.T) {
+	w := Workflow{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := w.run(ctx, func(ctx context.Context, devices []string, features discid.Feature, interval time.Duration) (discid.Disc, string, error) {
+		return discid.Disc{}, "", ctx.Err()
+	}, nil)
+	assert.Equal(t, context.Canceled, err)
+}
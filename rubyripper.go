@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"io"
+	"regexp"
+)
+
+var rubyripperTrackRegexp = regexp.MustCompile(
+	`^\s*Track\s+(?P<track>\d+):\s+start (?P<start>\d+), length (?P<length>\d+) sectors`)
+
+// Parse a Rubyripper log and return a Disc instance for the TOC contained
+// in it.
+//
+// The TOC is read from the "Track N: start X, length Y sectors" lines of the
+// TOC info section, which lists all audio tracks of the disc independent of
+// the tracks which actually got ripped.
+func ParseRubyripperLog(r io.Reader) (Disc, error) {
+	return parseTrackLines(r, rubyripperTrackRegexp)
+}
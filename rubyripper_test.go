@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestParseRubyripperLog(t *testing.T) {
+	f, err := os.Open("testdata/rubyripper.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	disc, err := discid.ParseRubyripperLog(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "lSOVc5h6IXSuzcamJS1Gp4_tRuA-", disc.Id())
+	assert.Equal(t,
+		"1 11 242457 150 44942 61305 72755 96360 130485 147315 164275 190702 205412 220437",
+		disc.TocString())
+}
+
+func TestParseRubyripperLogNoTracks(t *testing.T) {
+	log := "Rubyripper version 0.6.2 log file\n\nTrack 1\n\n  Copy finished\n"
+	_, err := discid.ParseRubyripperLog(strings.NewReader(log))
+	assert.EqualError(t, err, "no tracks found")
+}
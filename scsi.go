@@ -0,0 +1,255 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SCSI multimedia commands (MMC) used for reading discs with SCSI generic
+// devices
+const (
+	scsiReadSubChannel = 0x42
+	scsiReadToc        = 0x43
+	scsiReadCd         = 0xbe
+)
+
+// Formats of the READ SUB-CHANNEL command
+const (
+	subChannelMcn  = 0x02
+	subChannelIsrc = 0x03
+)
+
+// Build a 10 byte command descriptor block reading the TOC with LBA
+// addresses.
+func readTocCommand(allocLen int) []byte {
+	cdb := make([]byte, 10)
+	cdb[0] = scsiReadToc
+	binary.BigEndian.PutUint16(cdb[7:9], uint16(allocLen))
+	return cdb
+}
+
+// Build a 10 byte command descriptor block reading the Q sub-channel data
+// in the given format. track is only used for ISRCs.
+func readSubChannelCommand(format byte, track int, allocLen int) []byte {
+	cdb := make([]byte, 10)
+	cdb[0] = scsiReadSubChannel
+	cdb[2] = 0x40 // SubQ
+	cdb[3] = format
+	cdb[6] = byte(track)
+	binary.BigEndian.PutUint16(cdb[7:9], uint16(allocLen))
+	return cdb
+}
+
+// Build a 12 byte command descriptor block reading only the formatted Q
+// sub-channel data of the sector at the given LBA, without any main channel
+// data.
+func readCdSubQCommand(lba int) []byte {
+	cdb := make([]byte, 12)
+	cdb[0] = scsiReadCd
+	binary.BigEndian.PutUint32(cdb[2:6], uint32(lba))
+	cdb[8] = 1     // Transfer length in sectors
+	cdb[10] = 0x02 // Formatted Q sub-channel
+	return cdb
+}
+
+// A TOC read with the READ TOC command
+type scsiToc struct {
+	first   int
+	lbas    []int
+	leadout int
+	data    map[int]bool
+}
+
+// Parse the response of the READ TOC command (format 0, LBA addresses).
+//
+// Like libdiscid the data session of a multi-session disc (Enhanced CD) is
+// excluded, the leadout then is the end of the audio session.
+func parseTocResponse(resp []byte) (scsiToc, error) {
+	if len(resp) < 4 {
+		return scsiToc{}, errors.New("TOC response too short")
+	}
+	length := int(binary.BigEndian.Uint16(resp[0:2])) + 2
+	if length > len(resp) {
+		return scsiToc{}, errors.New("TOC response truncated")
+	}
+	toc := scsiToc{first: int(resp[2]), leadout: -1, data: make(map[int]bool)}
+	last := int(resp[3])
+	for i := 4; i+8 <= length; i += 8 {
+		desc := resp[i : i+8]
+		track := int(desc[2])
+		lba := int(int32(binary.BigEndian.Uint32(desc[4:8])))
+		if track == LeadoutTrackNumber {
+			toc.leadout = lba
+		} else if track >= toc.first && track <= last {
+			toc.lbas = append(toc.lbas, lba)
+			toc.data[track] = desc[1]&0x04 != 0
+		}
+	}
+	if toc.leadout < 0 || len(toc.lbas) != last-toc.first+1 {
+		return scsiToc{}, fmt.Errorf("incomplete TOC for tracks %v to %v", toc.first, last)
+	}
+	if n := len(toc.lbas); n > 1 && toc.data[last] && !toc.data[last-1] {
+		toc.leadout = toc.lbas[n-1] - dataSessionGap
+		toc.lbas = toc.lbas[:n-1]
+		delete(toc.data, last)
+	}
+	return toc, nil
+}
+
+// Parse the response of the READ SUB-CHANNEL command for the MCN. An empty
+// string is returned if the disc has no MCN.
+func parseMcnResponse(resp []byte) string {
+	if len(resp) < 22 || resp[8]&0x80 == 0 {
+		return ""
+	}
+	return strings.TrimRight(string(resp[9:22]), "\x00")
+}
+
+// Parse the response of the READ SUB-CHANNEL command for an ISRC. An empty
+// string is returned if the track has no ISRC.
+func parseIsrcResponse(resp []byte) string {
+	if len(resp) < 21 || resp[8]&0x80 == 0 {
+		return ""
+	}
+	return strings.TrimRight(string(resp[9:21]), "\x00")
+}
+
+// Parse the formatted Q sub-channel data of a sector returned by the READ CD
+// command and return the index number. ok is false if the Q data holds no
+// position, but e.g. the MCN or an ISRC.
+func parseSubQIndex(resp []byte) (index int, ok bool) {
+	if len(resp) < 16 || resp[0]&0x0f != 1 {
+		return 0, false
+	}
+	return int(resp[2]>>4)*10 + int(resp[2]&0x0f), true
+}
+
+// Read the start offsets of the sub-indexes 2 and above of the audio tracks
+// from the Q sub-channel.
+//
+// The index at the last sector of a track tells how many indexes the track
+// has, the start of each index is then searched with a binary search. Most
+// tracks only have index 1 and cost a single command.
+func readScsiIndexes(toc scsiToc, command func(cdb []byte, allocLen int) ([]byte, error)) (map[int][]int, error) {
+	const subQAllocLen = 16
+	indexAt := func(lba int) (int, error) {
+		// At least 9 of 10 sectors hold the position, the others the MCN or
+		// an ISRC. Fall back to the following sectors for these.
+		for i := 0; i < 3; i++ {
+			resp, err := command(readCdSubQCommand(lba+i), subQAllocLen)
+			if err != nil {
+				return 0, err
+			}
+			if index, ok := parseSubQIndex(resp); ok {
+				return index, nil
+			}
+		}
+		return 0, fmt.Errorf("no position in Q sub-channel at sector %v", lba)
+	}
+	indexes := make(map[int][]int)
+	for i, start := range toc.lbas {
+		track := toc.first + i
+		if toc.data[track] {
+			continue
+		}
+		end := toc.leadout - 1
+		if i+1 < len(toc.lbas) {
+			end = toc.lbas[i+1] - 1
+		}
+		last, err := indexAt(end)
+		if err != nil {
+			return nil, err
+		}
+		low := start
+		for index := 2; index <= last; index++ {
+			// Find the first sector with at least this index
+			high := end
+			for low < high {
+				mid := low + (high-low)/2
+				found, err := indexAt(mid)
+				if err != nil {
+					return nil, err
+				}
+				if found >= index {
+					high = mid
+				} else {
+					low = mid + 1
+				}
+			}
+			indexes[track] = append(indexes[track], low+pregapSectors)
+		}
+	}
+	return indexes, nil
+}
+
+// Report whether the device name refers to a SCSI generic device.
+func isSgDevice(device string) bool {
+	return strings.HasPrefix(device, "/dev/sg")
+}
+
+// Read the disc using the given function for sending SCSI commands, which
+// returns the response data for a command descriptor block.
+func readScsi(features Feature, command func(cdb []byte, allocLen int) ([]byte, error)) (Disc, error) {
+	const tocAllocLen = 4 + 100*8
+	resp, err := command(readTocCommand(tocAllocLen), tocAllocLen)
+	if err != nil {
+		return Disc{}, err
+	}
+	toc, err := parseTocResponse(resp)
+	if err != nil {
+		return Disc{}, err
+	}
+	disc, err := putLbas(toc.first, toc.lbas, toc.leadout)
+	if err != nil {
+		return Disc{}, err
+	}
+	overlay := overlayHandle{handle: disc.handle, data: toc.data}
+	const subChannelAllocLen = 24
+	if features&FeatureMcn != 0 {
+		resp, err := command(readSubChannelCommand(subChannelMcn, 0, subChannelAllocLen), subChannelAllocLen)
+		if err != nil {
+			disc.Close()
+			return Disc{}, err
+		}
+		overlay.mcnStr = parseMcnResponse(resp)
+	}
+	if features&FeatureIsrc != 0 {
+		overlay.isrcs = make(map[int]string)
+		for i := range toc.lbas {
+			track := toc.first + i
+			if toc.data[track] {
+				continue
+			}
+			resp, err := command(readSubChannelCommand(subChannelIsrc, track, subChannelAllocLen), subChannelAllocLen)
+			if err != nil {
+				disc.Close()
+				return Disc{}, err
+			}
+			overlay.isrcs[track] = parseIsrcResponse(resp)
+		}
+	}
+	if features&FeatureIndexes != 0 {
+		if overlay.indexes, err = readScsiIndexes(toc, command); err != nil {
+			disc.Close()
+			return Disc{}, err
+		}
+	}
+	return Disc{overlay}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// The SG_IO ioctl and its constants, see scsi/sg.h
+const (
+	sgIo           = 0x2285
+	sgDxferFromDev = -3
+	sgTimeoutMs    = 30000
+)
+
+// struct sg_io_hdr from scsi/sg.h
+type sgIoHdr struct {
+	interfaceId    int32
+	dxferDirection int32
+	cmdLen         uint8
+	mxSbLen        uint8
+	iovecCount     uint16
+	dxferLen       uint32
+	dxferp         unsafe.Pointer
+	cmdp           unsafe.Pointer
+	sbp            unsafe.Pointer
+	timeout        uint32
+	flags          uint32
+	packId         int32
+	usrPtr         unsafe.Pointer
+	status         uint8
+	maskedStatus   uint8
+	msgStatus      uint8
+	sbLenWr        uint8
+	hostStatus     uint16
+	driverStatus   uint16
+	resid          int32
+	duration       uint32
+	info           uint32
+}
+
+// Read the disc in a SCSI generic device by sending the SCSI commands with
+// the SG_IO ioctl.
+//
+// If the context gets cancelled the device is closed, which makes the read
+// fail with the context's error. The SCSI command in flight cannot be
+// interrupted, the descriptor is only released once it returned.
+func readSgDevice(ctx context.Context, device string, features Feature) (Disc, error) {
+	f, err := os.OpenFile(device, os.O_RDWR|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return Disc{}, err
+	}
+	defer f.Close()
+	conn, err := f.SyscallConn()
+	if err != nil {
+		return Disc{}, err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			f.Close()
+		case <-done:
+		}
+	}()
+	return readScsi(features, func(cdb []byte, allocLen int) ([]byte, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var resp []byte
+		var cmdErr error
+		// Control holds a reference to the descriptor, hence closing the
+		// file never closes it during the ioctl.
+		err := conn.Control(func(fd uintptr) {
+			resp, cmdErr = sgCommand(int(fd), cdb, allocLen)
+		})
+		if ctxErr := ctx.Err(); ctxErr != nil && (err != nil || cmdErr != nil) {
+			return nil, ctxErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		return resp, cmdErr
+	})
+}
+
+// Send a SCSI command reading allocLen bytes.
+func sgCommand(fd int, cdb []byte, allocLen int) ([]byte, error) {
+	buf := make([]byte, allocLen)
+	sense := make([]byte, 32)
+	hdr := sgIoHdr{
+		interfaceId:    'S',
+		dxferDirection: sgDxferFromDev,
+		cmdLen:         uint8(len(cdb)),
+		mxSbLen:        uint8(len(sense)),
+		dxferLen:       uint32(len(buf)),
+		dxferp:         unsafe.Pointer(&buf[0]),
+		cmdp:           unsafe.Pointer(&cdb[0]),
+		sbp:            unsafe.Pointer(&sense[0]),
+		timeout:        sgTimeoutMs,
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), sgIo, uintptr(unsafe.Pointer(&hdr)))
+	runtime.KeepAlive(buf)
+	runtime.KeepAlive(cdb)
+	runtime.KeepAlive(sense)
+	if errno != 0 {
+		return nil, errno
+	}
+	if hdr.status != 0 || hdr.hostStatus != 0 || hdr.driverStatus != 0 {
+		return nil, fmt.Errorf("SCSI command 0x%02x failed with status 0x%02x (host 0x%x, driver 0x%x)",
+			cdb[0], hdr.status, hdr.hostStatus, hdr.driverStatus)
+	}
+	return buf[:len(buf)-int(hdr.resid)], nil
+}
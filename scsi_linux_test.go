@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSgDeviceCancelled(t *testing.T) {
+	f, err := ioutil.TempFile("", "sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = readSgDevice(ctx, f.Name(), FeatureAll)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestReadSgDeviceNotSupported(t *testing.T) {
+	f, err := ioutil.TempFile("", "sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	// A regular file does not support the SG_IO ioctl
+	_, err = readSgDevice(context.Background(), f.Name(), FeatureRead)
+	assert.Error(t, err)
+	assert.NotEqual(t, context.Canceled, err)
+}
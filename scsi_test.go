@@ -0,0 +1,161 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Build a READ TOC response for tracks starting with number 1. Tracks with
+// a negative LBA are data tracks.
+func tocResponse(lbas []int, leadout int) []byte {
+	resp := make([]byte, 4+(len(lbas)+1)*8)
+	binary.BigEndian.PutUint16(resp[0:2], uint16(len(resp)-2))
+	resp[2] = 1
+	resp[3] = byte(len(lbas))
+	for i, lba := range append(lbas, leadout) {
+		desc := resp[4+i*8 : 12+i*8]
+		desc[1] = 0x10
+		desc[2] = byte(i + 1)
+		if i == len(lbas) {
+			desc[2] = LeadoutTrackNumber
+		}
+		if lba < 0 {
+			desc[1] |= 0x04
+			lba = -lba
+		}
+		binary.BigEndian.PutUint32(desc[4:8], uint32(lba))
+	}
+	return resp
+}
+
+func subChannelResponse(value string) []byte {
+	resp := make([]byte, 24)
+	resp[8] = 0x80
+	copy(resp[9:], value)
+	return resp
+}
+
+func TestReadScsi(t *testing.T) {
+	assert := assert.New(t)
+	commands := []byte{}
+	disc, err := readScsi(FeatureAll, func(cdb []byte, allocLen int) ([]byte, error) {
+		commands = append(commands, cdb[0])
+		switch {
+		case cdb[0] == scsiReadToc:
+			return tocResponse([]int{0, 18751, 39588}, 206385), nil
+		case cdb[3] == subChannelMcn:
+			return subChannelResponse("4006381333931"), nil
+		case cdb[6] == 2:
+			return make([]byte, 24), nil
+		default:
+			return subChannelResponse("DEA12340000" + string(rune('0'+cdb[6]))), nil
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal("1 3 206535 150 18901 39738", disc.TocString())
+	assert.Equal("4006381333931", disc.Mcn())
+	assert.Equal("DEA123400001", disc.Track(1).Isrc)
+	assert.Equal("", disc.Track(2).Isrc)
+	assert.Equal("DEA123400003", disc.Track(3).Isrc)
+	assert.Equal([]byte{scsiReadToc, scsiReadSubChannel, scsiReadSubChannel,
+		scsiReadSubChannel, scsiReadSubChannel}, commands)
+}
+
+// Build a formatted Q sub-channel response for the given index. Index 0
+// builds a response without position, like for MCN and ISRC frames.
+func subQResponse(index int) []byte {
+	resp := make([]byte, 16)
+	resp[0] = 0x13
+	if index > 0 {
+		resp[0] = 0x11
+		resp[2] = byte(index/10<<4 | index%10)
+	}
+	return resp
+}
+
+func TestReadScsiIndexes(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := readScsi(FeatureRead|FeatureIndexes, func(cdb []byte, allocLen int) ([]byte, error) {
+		if cdb[0] == scsiReadToc {
+			return tocResponse([]int{0, 18751, 39588}, 206385), nil
+		}
+		assert.Equal(byte(scsiReadCd), cdb[0])
+		lba := int(binary.BigEndian.Uint32(cdb[2:6]))
+		switch {
+		case lba == 25000:
+			return subQResponse(0), nil
+		case lba >= 30000 && lba < 39588:
+			return subQResponse(3), nil
+		case lba >= 25000 && lba < 39588:
+			return subQResponse(2), nil
+		default:
+			return subQResponse(1), nil
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Empty(disc.Track(1).Indexes)
+	assert.Equal([]int{25150, 30150}, disc.Track(2).Indexes)
+	assert.Empty(disc.Track(3).Indexes)
+}
+
+func TestParseSubQIndex(t *testing.T) {
+	index, ok := parseSubQIndex(subQResponse(12))
+	assert.True(t, ok)
+	assert.Equal(t, 12, index)
+	_, ok = parseSubQIndex(subQResponse(0))
+	assert.False(t, ok)
+	_, ok = parseSubQIndex(make([]byte, 4))
+	assert.False(t, ok)
+}
+
+func TestReadScsiEnhancedCd(t *testing.T) {
+	disc, err := readScsi(FeatureRead, func(cdb []byte, allocLen int) ([]byte, error) {
+		return tocResponse([]int{0, 18751, -60000}, 90000), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "1 2 48750 150 18901", disc.TocString())
+}
+
+func TestReadScsiError(t *testing.T) {
+	failure := errors.New("SCSI command failed")
+	_, err := readScsi(FeatureRead, func(cdb []byte, allocLen int) ([]byte, error) {
+		return nil, failure
+	})
+	assert.Equal(t, failure, err)
+	_, err = readScsi(FeatureRead, func(cdb []byte, allocLen int) ([]byte, error) {
+		return tocResponse([]int{0, 18751}, 90000)[:20], nil
+	})
+	assert.EqualError(t, err, "TOC response truncated")
+}
+
+func TestIsSgDevice(t *testing.T) {
+	assert.True(t, isSgDevice("/dev/sg1"))
+	assert.False(t, isSgDevice("/dev/sr0"))
+}
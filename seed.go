@@ -0,0 +1,193 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// URL of the MusicBrainz release editor accepting seeded data
+const ReleaseEditorUrl = "https://musicbrainz.org/release/add"
+
+// Optional metadata used for seeding the MusicBrainz release editor.
+//
+// All fields may be left empty, the release editor will then ask for the
+// missing data.
+type ReleaseMetadata struct {
+	// Title of the release
+	Title string
+	// Name of the release artist
+	Artist string
+	// Titles of the tracks, starting with the first track on the disc
+	TrackTitles []string
+	// Barcode of the release. If empty the disc's MCN is used.
+	Barcode string
+	// Edit note to add to the edit
+	EditNote string
+}
+
+// Data for seeding the MusicBrainz release editor.
+//
+// This is the nested structure of the seed parameters, encoded as JSON by
+// discid.ReleaseEditorSeedJson. Empty fields are omitted.
+type ReleaseSeed struct {
+	Name         string            `json:"name,omitempty"`
+	ArtistCredit *SeedArtistCredit `json:"artist_credit,omitempty"`
+	Barcode      string            `json:"barcode,omitempty"`
+	EditNote     string            `json:"edit_note,omitempty"`
+	Mediums      []SeedMedium      `json:"mediums"`
+}
+
+// The artist credit of a seeded release
+type SeedArtistCredit struct {
+	Names []SeedArtistName `json:"names"`
+}
+
+// A credited artist name of a seeded release
+type SeedArtistName struct {
+	Name string `json:"name"`
+}
+
+// A medium of a seeded release
+type SeedMedium struct {
+	Format string `json:"format"`
+	// The TOC as returned by Disc.TocString, attaching the disc ID to the
+	// medium
+	Toc   string      `json:"toc"`
+	Track []SeedTrack `json:"track"`
+}
+
+// A track of a seeded medium
+type SeedTrack struct {
+	Number string `json:"number"`
+	Name   string `json:"name,omitempty"`
+	// Track length in milliseconds
+	Length int `json:"length"`
+}
+
+// Return the seed data for the MusicBrainz release editor.
+func NewReleaseSeed(d Disc, meta ReleaseMetadata) ReleaseSeed {
+	seed := ReleaseSeed{
+		Name:     meta.Title,
+		Barcode:  meta.Barcode,
+		EditNote: meta.EditNote,
+	}
+	if meta.Artist != "" {
+		seed.ArtistCredit = &SeedArtistCredit{Names: []SeedArtistName{{meta.Artist}}}
+	}
+	if seed.Barcode == "" {
+		seed.Barcode = d.Mcn()
+	}
+	medium := SeedMedium{Format: "CD", Toc: d.TocString(), Track: []SeedTrack{}}
+	first := d.FirstTrackNum()
+	for n := first; n <= d.LastTrackNum(); n++ {
+		i := n - first
+		track := d.Track(n)
+		seedTrack := SeedTrack{
+			Number: fmt.Sprint(track.Number),
+			// Track lengths are given in milliseconds
+			Length: int(SectorsToDuration(track.Sectors) / time.Millisecond),
+		}
+		if i < len(meta.TrackTitles) {
+			seedTrack.Name = meta.TrackTitles[i]
+		}
+		medium.Track = append(medium.Track, seedTrack)
+	}
+	seed.Mediums = []SeedMedium{medium}
+	return seed
+}
+
+// Return the form parameters for seeding the MusicBrainz release editor.
+//
+// The parameters contain the track lengths and the disc's TOC, so the disc ID
+// gets attached to the new release. Submit the parameters as a POST request
+// to ReleaseEditorUrl from the user's browser, e.g. using the form returned by
+// discid.ReleaseEditorSeedForm.
+//
+// See https://musicbrainz.org/doc/Development/Release_Editor_Seeding for
+// details about the parameters.
+func ReleaseEditorSeed(d Disc, meta ReleaseMetadata) url.Values {
+	return NewReleaseSeed(d, meta).Values()
+}
+
+// Return the seed as form parameters, see discid.ReleaseEditorSeed.
+func (s ReleaseSeed) Values() url.Values {
+	seed := url.Values{}
+	setIfNotEmpty := func(key string, value string) {
+		if value != "" {
+			seed.Set(key, value)
+		}
+	}
+	setIfNotEmpty("name", s.Name)
+	if s.ArtistCredit != nil {
+		for i, name := range s.ArtistCredit.Names {
+			setIfNotEmpty(fmt.Sprintf("artist_credit.names.%d.name", i), name.Name)
+		}
+	}
+	setIfNotEmpty("barcode", s.Barcode)
+	setIfNotEmpty("edit_note", s.EditNote)
+	for m, medium := range s.Mediums {
+		mediumPrefix := fmt.Sprintf("mediums.%d.", m)
+		setIfNotEmpty(mediumPrefix+"format", medium.Format)
+		setIfNotEmpty(mediumPrefix+"toc", medium.Toc)
+		for i, track := range medium.Track {
+			prefix := fmt.Sprintf("%vtrack.%d.", mediumPrefix, i)
+			seed.Set(prefix+"number", track.Number)
+			seed.Set(prefix+"length", fmt.Sprint(track.Length))
+			setIfNotEmpty(prefix+"name", track.Name)
+		}
+	}
+	return seed
+}
+
+// Return the seed data for the MusicBrainz release editor as JSON.
+//
+// The JSON contains the same data as the parameters returned by
+// discid.ReleaseEditorSeed in nested form, e.g. for programmatic workflows
+// passing the seed between tools. Use ReleaseSeed.Values to convert decoded
+// seed data back into form parameters.
+func ReleaseEditorSeedJson(d Disc, meta ReleaseMetadata) ([]byte, error) {
+	return json.Marshal(NewReleaseSeed(d, meta))
+}
+
+// Return a HTML form seeding the MusicBrainz release editor.
+//
+// The form contains the parameters returned by discid.ReleaseEditorSeed as
+// hidden fields and a submit button. It can be embedded into a web page or
+// written to a local HTML file and opened in the browser.
+func ReleaseEditorSeedForm(d Disc, meta ReleaseMetadata) string {
+	seed := ReleaseEditorSeed(d, meta)
+	keys := make([]string, 0, len(seed))
+	for key := range seed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	fmt.Fprintf(&b, "<form action=\"%v\" method=\"post\">\n", ReleaseEditorUrl)
+	for _, key := range keys {
+		fmt.Fprintf(&b, "  <input type=\"hidden\" name=\"%v\" value=\"%v\">\n",
+			html.EscapeString(key), html.EscapeString(seed.Get(key)))
+	}
+	b.WriteString("  <button type=\"submit\">Add release to MusicBrainz</button>\n")
+	b.WriteString("</form>\n")
+	return b.String()
+}
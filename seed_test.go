@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestReleaseEditorSeed(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.Parse("1 2 34567 150 10000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	seed := discid.ReleaseEditorSeed(disc, discid.ReleaseMetadata{
+		Title:       "The Album",
+		Artist:      "The Artist",
+		TrackTitles: []string{"First"},
+	})
+	assert.Equal("The Album", seed.Get("name"))
+	assert.Equal("The Artist", seed.Get("artist_credit.names.0.name"))
+	assert.Equal("CD", seed.Get("mediums.0.format"))
+	assert.Equal("1 2 34567 150 10000", seed.Get("mediums.0.toc"))
+	assert.Equal("1", seed.Get("mediums.0.track.0.number"))
+	assert.Equal("131333", seed.Get("mediums.0.track.0.length"))
+	assert.Equal("First", seed.Get("mediums.0.track.0.name"))
+	assert.Equal("2", seed.Get("mediums.0.track.1.number"))
+	assert.Equal("327560", seed.Get("mediums.0.track.1.length"))
+	assert.NotContains(seed, "mediums.0.track.1.name")
+	assert.NotContains(seed, "barcode")
+}
+
+func TestReleaseEditorSeedJson(t *testing.T) {
+	disc, err := discid.Parse("1 2 34567 150 10000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	meta := discid.ReleaseMetadata{
+		Title:       "The Album",
+		Artist:      "The Artist",
+		TrackTitles: []string{"First"},
+	}
+	data, err := discid.ReleaseEditorSeedJson(disc, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"name": "The Album",
+		"artist_credit": {"names": [{"name": "The Artist"}]},
+		"mediums": [{
+			"format": "CD",
+			"toc": "1 2 34567 150 10000",
+			"track": [
+				{"number": "1", "name": "First", "length": 131333},
+				{"number": "2", "length": 327560}
+			]
+		}]
+	}`, string(data))
+
+	var seed discid.ReleaseSeed
+	if err := json.Unmarshal(data, &seed); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, discid.ReleaseEditorSeed(disc, meta), seed.Values())
+}
+
+func TestReleaseEditorSeedForm(t *testing.T) {
+	disc, err := discid.Parse("1 1 44942 150")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	form := discid.ReleaseEditorSeedForm(disc, discid.ReleaseMetadata{Title: "Rock & Roll"})
+	assert.Contains(t, form, `<form action="https://musicbrainz.org/release/add" method="post">`)
+	assert.Contains(t, form, `<input type="hidden" name="name" value="Rock &amp; Roll">`)
+	assert.Contains(t, form, `<input type="hidden" name="mediums.0.toc" value="1 1 44942 150">`)
+}
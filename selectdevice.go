@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "errors"
+
+// Returned by discid.SelectDevice, discid.ReadAny and discid.WaitForDisc if
+// drives were found, but none of them matches.
+//
+// If the system has no disc drive at all these functions return ErrNoDrive
+// instead. Callers interested in both cases should check for both errors.
+var ErrNoDevice = errors.New("no matching disc drive found")
+
+// Strategy for selecting a drive with discid.SelectDevice
+type DeviceStrategy int
+
+const (
+	// Select the first drive containing a disc of any type
+	FirstWithMedia DeviceStrategy = iota
+	// Select the first drive containing a disc with audio tracks
+	FirstAudioCD
+	// Select the first internal drive. External drives, e.g. connected via
+	// USB, are only selected if there is no internal drive.
+	PreferInternal
+)
+
+// Checks used for selecting a drive, replaced in tests
+type deviceProbe struct {
+	hasMedia   func(device string) bool
+	hasAudio   func(device string) bool
+	isExternal func(device string) bool
+}
+
+var defaultDeviceProbe = deviceProbe{
+	hasMedia:   hasMedia,
+	hasAudio:   hasAudio,
+	isExternal: isExternalDevice,
+}
+
+// Select a drive from the drives returned by discid.ListDevices.
+//
+// This can be used instead of the platform's default device, which might
+// not be the drive containing a disc. If no drive matches the strategy
+// ErrNoDevice is returned, if the system has no drive at all ErrNoDrive.
+//
+// Detecting a disc without reading it is implemented on Linux, on other
+// platforms reading the TOC is attempted, so only audio CDs are detected
+// with FirstWithMedia. External drives are only detected on Linux, on other
+// platforms PreferInternal selects the first drive.
+func SelectDevice(strategy DeviceStrategy) (string, error) {
+	return selectDevice(strategy, ListDevices(), defaultDeviceProbe)
+}
+
+func selectDevice(strategy DeviceStrategy, devices []string, probe deviceProbe) (string, error) {
+	if len(devices) == 0 {
+		return "", ErrNoDrive
+	}
+	switch strategy {
+	case FirstWithMedia:
+		for _, device := range devices {
+			if probe.hasMedia(device) {
+				return device, nil
+			}
+		}
+	case FirstAudioCD:
+		for _, device := range devices {
+			if probe.hasMedia(device) && probe.hasAudio(device) {
+				return device, nil
+			}
+		}
+	case PreferInternal:
+		for _, device := range devices {
+			if !probe.isExternal(device) {
+				return device, nil
+			}
+		}
+		if len(devices) > 0 {
+			return devices[0], nil
+		}
+	default:
+		return "", errors.New("unknown device strategy")
+	}
+	return "", ErrNoDevice
+}
+
+// Check whether the drive contains a disc with audio tracks by reading
+// its TOC.
+func hasAudio(device string) bool {
+	disc, err := Read(device)
+	if err != nil {
+		return false
+	}
+	disc.Close()
+	return true
+}
+
+// Read the first audio CD found in any of the drives returned by
+// discid.ListDevices.
+//
+// Returns the disc together with the device it was read from. If none of
+// the drives contains an audio CD ErrNoDevice is returned, if the system
+// has no drive at all ErrNoDrive.
+func ReadAny(features Feature) (Disc, string, error) {
+	return readAny(ListDevices(), features)
+}
+
+func readAny(devices []string, features Feature) (Disc, string, error) {
+	if len(devices) == 0 {
+		return Disc{}, "", ErrNoDrive
+	}
+	for _, device := range devices {
+		if !hasMedia(device) {
+			continue
+		}
+		disc, err := ReadFeatures(device, features)
+		if err == nil {
+			return disc, device, nil
+		}
+	}
+	return Disc{}, "", ErrNoDevice
+}
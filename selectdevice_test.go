@@ -0,0 +1,75 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDeviceProbe() deviceProbe {
+	media := map[string]bool{"/dev/sr1": true, "/dev/sr2": true}
+	audio := map[string]bool{"/dev/sr2": true}
+	external := map[string]bool{"/dev/sr0": true}
+	return deviceProbe{
+		hasMedia:   func(d string) bool { return media[d] },
+		hasAudio:   func(d string) bool { return audio[d] },
+		isExternal: func(d string) bool { return external[d] },
+	}
+}
+
+func TestSelectDevice(t *testing.T) {
+	assert := assert.New(t)
+	devices := []string{"/dev/sr0", "/dev/sr1", "/dev/sr2"}
+	probe := testDeviceProbe()
+	device, err := selectDevice(FirstWithMedia, devices, probe)
+	assert.NoError(err)
+	assert.Equal("/dev/sr1", device)
+	device, err = selectDevice(FirstAudioCD, devices, probe)
+	assert.NoError(err)
+	assert.Equal("/dev/sr2", device)
+	device, err = selectDevice(PreferInternal, devices, probe)
+	assert.NoError(err)
+	assert.Equal("/dev/sr1", device)
+}
+
+func TestSelectDeviceNoMatch(t *testing.T) {
+	assert := assert.New(t)
+	probe := testDeviceProbe()
+	_, err := selectDevice(FirstWithMedia, []string{"/dev/sr0"}, probe)
+	assert.Equal(ErrNoDevice, err)
+	_, err = selectDevice(FirstAudioCD, []string{"/dev/sr1"}, probe)
+	assert.Equal(ErrNoDevice, err)
+	_, err = selectDevice(PreferInternal, []string{}, probe)
+	assert.Equal(ErrNoDrive, err)
+	_, err = selectDevice(DeviceStrategy(99), []string{"/dev/sr1"}, probe)
+	assert.EqualError(err, "unknown device strategy")
+}
+
+func TestSelectDevicePreferInternalFallback(t *testing.T) {
+	device, err := selectDevice(PreferInternal, []string{"/dev/sr0"}, testDeviceProbe())
+	assert.NoError(t, err)
+	assert.Equal(t, "/dev/sr0", device)
+}
+
+func TestReadAnyNoDisc(t *testing.T) {
+	_, device, err := readAny([]string{"notadevice", "notadevice2"}, FeatureRead)
+	assert.Equal(t, ErrNoDevice, err)
+	assert.Equal(t, "", device)
+	_, _, err = readAny([]string{}, FeatureRead)
+	assert.Equal(t, ErrNoDrive, err)
+}
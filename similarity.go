@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Difference in sectors of the track lengths up to which two TOCs are
+// considered similar. This is 10 seconds per track on average.
+const SimilarityTolerance = 10 * SectorsPerSecond
+
+// Return a similarity score between 0 and 1 for the TOCs of two discs.
+//
+// Similar to the fuzzy TOC lookup of MusicBrainz the lengths of the tracks
+// are compared, so that TOCs differing by a constant offset are considered
+// identical. The score is 1 if all tracks have the same length and decreases
+// linearly with the summed up length differences, reaching 0 if the tracks
+// differ by SimilarityTolerance sectors on average. Discs with a different
+// number of tracks have a score of 0.
+//
+// The score can be used to rank candidate releases if no release matches
+// the disc ID exactly.
+func TocSimilarity(a Disc, b Disc) float64 {
+	first := a.FirstTrackNum()
+	count := a.LastTrackNum() - first + 1
+	if count != b.LastTrackNum()-b.FirstTrackNum()+1 {
+		return 0
+	}
+	distance := 0
+	for i := 0; i < count; i++ {
+		delta := a.handle.trackLength(first+i) - b.handle.trackLength(b.FirstTrackNum()+i)
+		if delta < 0 {
+			delta = -delta
+		}
+		distance += delta
+	}
+	score := 1 - float64(distance)/float64(SimilarityTolerance*count)
+	if score < 0 {
+		return 0
+	}
+	return score
+}
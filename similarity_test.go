@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func similarity(t *testing.T, a string, b string) float64 {
+	discA, err := discid.Parse(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer discA.Close()
+	discB, err := discid.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer discB.Close()
+	return discid.TocSimilarity(discA, discB)
+}
+
+func TestTocSimilarity(t *testing.T) {
+	toc := "1 3 300000 150 100000 200000"
+	assert.Equal(t, 1.0, similarity(t, toc, toc))
+	assert.Equal(t, 1.0, similarity(t, toc, "1 3 300010 160 100010 200010"))
+	assert.Equal(t, 1.0, similarity(t, toc, "2 4 300000 150 100000 200000"))
+	// 1125 sectors difference of 2250 allowed for three tracks
+	assert.InDelta(t, 0.5, similarity(t, toc, "1 3 298875 150 100000 200000"), 0.0001)
+	assert.Equal(t, 0.0, similarity(t, toc, "1 3 290000 150 100000 200000"))
+	assert.Equal(t, 0.0, similarity(t, toc, "1 2 200000 150 100000"))
+}
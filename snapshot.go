@@ -0,0 +1,73 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Holds a copy of all information about a disc (TOC, MCN, ISRCs).
+//
+// Unlike Disc a Snapshot does not reference any resources allocated by
+// libdiscid. It stays valid after the Disc it was created from has been
+// closed and can be encoded as JSON.
+type Snapshot struct {
+	// The MusicBrainz disc ID
+	Id string `json:"id"`
+	// The FreeDB disc ID
+	FreedbId string `json:"freedb_id"`
+	// The TOC string as returned by Disc.TocString
+	TocString string `json:"toc"`
+	// The number of the first track on the disc
+	FirstTrackNum int `json:"first_track"`
+	// The number of the last track on the disc
+	LastTrackNum int `json:"last_track"`
+	// The length of the disc in sectors
+	Sectors int `json:"sectors"`
+	// The Media Catalogue Number (MCN), if present
+	Mcn string `json:"mcn,omitempty"`
+	// All tracks of the disc ordered by track number
+	Tracks []Track `json:"tracks"`
+}
+
+// Return a snapshot of all the information available for this disc.
+func (d Disc) Snapshot() Snapshot {
+	first := d.FirstTrackNum()
+	last := d.LastTrackNum()
+	tracks := make([]Track, 0, last-first+1)
+	for n := first; n <= last; n++ {
+		tracks = append(tracks, d.Track(n))
+	}
+	return Snapshot{
+		Id:            d.Id(),
+		FreedbId:      d.FreedbId(),
+		TocString:     d.TocString(),
+		FirstTrackNum: first,
+		LastTrackNum:  last,
+		Sectors:       d.Sectors(),
+		Mcn:           d.Mcn(),
+		Tracks:        tracks,
+	}
+}
+
+// Return an URL for submitting the disc ID to MusicBrainz.
+//
+// The URL is built from the data of the snapshot and is identical to the
+// one returned by Disc.SubmissionUrl.
+func (s Snapshot) SubmissionUrl() string {
+	return buildSubmissionUrl(s.Id, s.TocString, s.LastTrackNum)
+}
+
+// Return the leadout as a pseudo-track, see Disc.Leadout.
+func (s Snapshot) Leadout() Track {
+	return leadoutTrack(s.Sectors)
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.Parse("1 3 34567 150 10000 20000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := disc.Snapshot()
+	assert.Equal(disc.Id(), s.Id)
+	assert.Equal(disc.FreedbId(), s.FreedbId)
+	assert.Equal(disc.TocString(), s.TocString)
+	submissionUrl := disc.SubmissionUrl()
+	leadout := disc.Leadout()
+	disc.Close()
+	assert.Equal(submissionUrl, s.SubmissionUrl())
+	assert.Equal(discid.Track{Number: discid.LeadoutTrackNumber, Offset: 34567}, leadout)
+	assert.Equal(leadout, s.Leadout())
+	assert.Equal(1, s.FirstTrackNum)
+	assert.Equal(3, s.LastTrackNum)
+	assert.Equal(34567, s.Sectors)
+	assert.Equal([]discid.Track{
+		{Number: 1, Offset: 150, Sectors: 9850},
+		{Number: 2, Offset: 10000, Sectors: 10000},
+		{Number: 3, Offset: 20000, Sectors: 14567},
+	}, s.Tracks)
+}
+
+func TestSnapshotJSON(t *testing.T) {
+	assert := assert.New(t)
+	s := discid.Snapshot{
+		Id:            "ANJa4DGYN_ktpzOwvVPtcjwP7mE-",
+		FreedbId:      "02025701",
+		TocString:     "1 1 44942 150",
+		FirstTrackNum: 1,
+		LastTrackNum:  1,
+		Sectors:       44942,
+		Tracks: []discid.Track{
+			{Number: 1, Offset: 150, Sectors: 44792, Isrc: "DEAAA0000001"},
+		},
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(`{
+		"id": "ANJa4DGYN_ktpzOwvVPtcjwP7mE-",
+		"freedb_id": "02025701",
+		"toc": "1 1 44942 150",
+		"first_track": 1,
+		"last_track": 1,
+		"sectors": 44942,
+		"tracks": [
+			{"number": 1, "offset": 150, "sectors": 44792, "isrc": "DEAAA0000001"}
+		]
+	}`, string(data))
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Read the disc in the given device in the background, sending each track
+// as soon as it is available.
+//
+// The disc is read with discid.ReadWithOptions. As soon as the TOC has been
+// read the tracks are sent on the returned track channel, which allows user
+// interfaces to fill the track list while the slow ISRC read is still
+// running. If opts.Features contains discid.FeatureIsrc each track is sent
+// a second time with its ISRC set. libdiscid reads all ISRCs in one call,
+// hence these updates arrive together once the ISRC read finished. The
+// track channel gets closed when the read finished, afterwards the result
+// is sent like with discid.ReadAsync.
+//
+// The streamed tracks contain the ISRCs of the first read. With
+// opts.IsrcReads or opts.FilterIsrcs the ISRCs of the final disc might
+// differ. Callbacks set in opts.Events are still called.
+func ReadStream(device string, opts ReadOptions) (<-chan Track, <-chan ReadResult) {
+	return readStream(opts, func(opts ReadOptions) (Disc, error) {
+		return ReadWithOptions(device, opts)
+	})
+}
+
+func readStream(opts ReadOptions, read func(ReadOptions) (Disc, error)) (<-chan Track, <-chan ReadResult) {
+	// A disc has at most 99 tracks, each is sent at most twice, hence
+	// sending never blocks.
+	tracks := make(chan Track, 2*99)
+	events := opts.Events
+	var toc Toc
+	// Retried reads, e.g. with SpinUpWait, emit the events again. The TOC
+	// tracks and the ISRC of each track are only sent once.
+	tocSent := false
+	isrcSent := make(map[int]bool)
+	opts.Events = func(event ReadEvent) {
+		switch event.Type {
+		case EventTocRead:
+			if tocSent {
+				break
+			}
+			toc, _ = ParseToc(event.Toc)
+			for n := toc.First; n <= toc.Last; n++ {
+				tracks <- toc.track(n)
+			}
+			tocSent = true
+		case EventIsrcRead:
+			if event.Track >= toc.First && event.Track <= toc.Last && !isrcSent[event.Track] {
+				track := toc.track(event.Track)
+				track.Isrc = event.Isrc
+				tracks <- track
+				isrcSent[event.Track] = true
+			}
+		}
+		if events != nil {
+			events(event)
+		}
+	}
+	results := readAsync(func() (Disc, error) {
+		defer close(tracks)
+		return read(opts)
+	})
+	return tracks, results
+}
+
+// Return the track with the given number, which must be between t.First
+// and t.Last.
+func (t Toc) track(number int) Track {
+	i := number - t.First
+	end := t.Leadout
+	if i+1 < len(t.TrackOffsets) {
+		end = t.TrackOffsets[i+1]
+	}
+	return Track{Number: number, Offset: t.TrackOffsets[i], Sectors: end - t.TrackOffsets[i]}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadStream(t *testing.T) {
+	assert := assert.New(t)
+	events := []ReadEventType{}
+	opts := ReadOptions{
+		Features: FeatureIsrc,
+		Events:   func(event ReadEvent) { events = append(events, event.Type) },
+	}
+	tracks, results := readStream(opts, func(opts ReadOptions) (Disc, error) {
+		opts.Events(ReadEvent{Type: EventTocRead, Toc: "1 2 39738 150 18901"})
+		opts.Events(ReadEvent{Type: EventIsrcRead, Track: 1, Isrc: "DEA123400001"})
+		opts.Events(ReadEvent{Type: EventIsrcRead, Track: 2})
+		return Parse("1 2 39738 150 18901")
+	})
+	streamed := []Track{}
+	for track := range tracks {
+		streamed = append(streamed, track)
+	}
+	assert.Equal([]Track{
+		{Number: 1, Offset: 150, Sectors: 18751},
+		{Number: 2, Offset: 18901, Sectors: 20837},
+		{Number: 1, Offset: 150, Sectors: 18751, Isrc: "DEA123400001"},
+		{Number: 2, Offset: 18901, Sectors: 20837},
+	}, streamed)
+	assert.Equal([]ReadEventType{EventTocRead, EventIsrcRead, EventIsrcRead}, events)
+	result := <-results
+	if assert.NoError(result.Err) {
+		result.Disc.Close()
+	}
+}
+
+func TestReadStreamWithoutIsrcs(t *testing.T) {
+	tracks, results := readStream(ReadOptions{}, func(opts ReadOptions) (Disc, error) {
+		opts.Events(ReadEvent{Type: EventTocRead, Toc: "1 2 39738 150 18901"})
+		return Disc{}, errors.New("failed")
+	})
+	count := 0
+	for range tracks {
+		count++
+	}
+	assert.Equal(t, 2, count)
+	assert.Error(t, (<-results).Err)
+}
+
+func TestReadStreamRetried(t *testing.T) {
+	assert := assert.New(t)
+	opts := ReadOptions{Features: FeatureIsrc}
+	tracks, results := readStream(opts, func(opts ReadOptions) (Disc, error) {
+		attempt := 0
+		return retryNotReady(time.Second, func(time.Duration) {}, func() (Disc, error) {
+			attempt++
+			opts.Events(ReadEvent{Type: EventTocRead, Toc: "1 2 39738 150 18901"})
+			if attempt == 1 {
+				return Disc{}, ErrNotReady
+			}
+			opts.Events(ReadEvent{Type: EventIsrcRead, Track: 1, Isrc: "DEA123400001"})
+			opts.Events(ReadEvent{Type: EventIsrcRead, Track: 2})
+			return Parse("1 2 39738 150 18901")
+		})
+	})
+	streamed := []Track{}
+	for track := range tracks {
+		streamed = append(streamed, track)
+	}
+	assert.Equal([]Track{
+		{Number: 1, Offset: 150, Sectors: 18751},
+		{Number: 2, Offset: 18901, Sectors: 20837},
+		{Number: 1, Offset: 150, Sectors: 18751, Isrc: "DEA123400001"},
+		{Number: 2, Offset: 18901, Sectors: 20837},
+	}, streamed)
+	result := <-results
+	if assert.NoError(result.Err) {
+		result.Disc.Close()
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "fmt"
+
+// Read only the Media Catalogue Number (MCN) of the disc in the given
+// device.
+//
+// This allows reading the MCN later for a disc already read with
+// discid.Read, e.g. only when the user asks for it. An empty string is
+// returned if the disc has no MCN or the platform does not support
+// reading it.
+func ReadMcn(device string) (string, error) {
+	return readMcn(func(features Feature) (Disc, error) {
+		return ReadFeatures(device, features)
+	})
+}
+
+func readMcn(read func(Feature) (Disc, error)) (string, error) {
+	disc, err := read(FeatureMcn)
+	if err != nil {
+		return "", err
+	}
+	defer disc.Close()
+	return disc.Mcn(), nil
+}
+
+// Read only the ISRCs of the given tracks of the disc in the given device.
+//
+// This allows reading the ISRCs later for a disc already read with
+// discid.Read. The returned map contains the ISRC for each of the given
+// track numbers, which might be empty. If tracks is empty the ISRCs of
+// all tracks are returned. libdiscid always reads the ISRCs of all tracks,
+// hence the read does not get faster with fewer tracks.
+func ReadIsrcs(device string, tracks []int) (map[int]string, error) {
+	return readIsrcs(tracks, func(features Feature) (Disc, error) {
+		return ReadFeatures(device, features)
+	})
+}
+
+func readIsrcs(tracks []int, read func(Feature) (Disc, error)) (map[int]string, error) {
+	disc, err := read(FeatureIsrc)
+	if err != nil {
+		return nil, err
+	}
+	defer disc.Close()
+	first := disc.FirstTrackNum()
+	last := disc.LastTrackNum()
+	if len(tracks) == 0 {
+		for n := first; n <= last; n++ {
+			tracks = append(tracks, n)
+		}
+	}
+	isrcs := make(map[int]string, len(tracks))
+	for _, n := range tracks {
+		if n < first || n > last {
+			return nil, fmt.Errorf("track number out of bounds: given %v, expected between %v and %v",
+				n, first, last)
+		}
+		isrcs[n] = disc.Track(n).Isrc
+	}
+	return isrcs, nil
+}
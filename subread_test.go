@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const subreadCue = `CATALOG 4006381333931
+FILE "disc.wav" WAVE
+  TRACK 01 AUDIO
+    ISRC DEA123400001
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    ISRC DEA123400002
+    INDEX 01 10:00:00
+`
+
+func readSubreadCue(features Feature) (Disc, error) {
+	return ParseCueSheet(strings.NewReader(subreadCue), 90000)
+}
+
+func TestReadMcn(t *testing.T) {
+	mcn, err := readMcn(readSubreadCue)
+	assert.NoError(t, err)
+	assert.Equal(t, "4006381333931", mcn)
+}
+
+func TestReadIsrcs(t *testing.T) {
+	assert := assert.New(t)
+	isrcs, err := readIsrcs([]int{2}, readSubreadCue)
+	assert.NoError(err)
+	assert.Equal(map[int]string{2: "DEA123400002"}, isrcs)
+	isrcs, err = readIsrcs(nil, readSubreadCue)
+	assert.NoError(err)
+	assert.Equal(map[int]string{1: "DEA123400001", 2: "DEA123400002"}, isrcs)
+	_, err = readIsrcs([]int{3}, readSubreadCue)
+	assert.Error(err)
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Write the tracks of the disc as aligned table.
+//
+// The table has one row per track with the track number, the start and the
+// length as MM:SS:FF, the offset and the length in sectors and the ISRC.
+// Data tracks are marked with "(data)".
+func (d Disc) FormatTable(w io.Writer) error {
+	return d.Snapshot().FormatTable(w)
+}
+
+// Write the tracks as aligned table, see Disc.FormatTable.
+func (s Snapshot) FormatTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TRACK\tSTART\tLENGTH\tOFFSET\tSECTORS\tISRC")
+	for _, track := range s.Tracks {
+		isrc := track.Isrc
+		if track.Data {
+			isrc = "(data)"
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\n", track.Number,
+			track.OffsetMSF(), track.LengthMSF(), track.Offset, track.Sectors, isrc)
+	}
+	return tw.Flush()
+}
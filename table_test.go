@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestFormatTable(t *testing.T) {
+	disc, err := discid.Parse("1 2 39738 150 18901")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	var b strings.Builder
+	if err := disc.FormatTable(&b); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "TRACK  START     LENGTH    OFFSET  SECTORS  ISRC\n"+
+		"1      00:02:00  04:10:01  150     18751    \n"+
+		"2      04:12:01  04:37:62  18901   20837    \n", b.String())
+}
+
+func TestFormatTableSnapshot(t *testing.T) {
+	s := discid.Snapshot{Tracks: []discid.Track{
+		{Number: 1, Offset: 150, Sectors: 750, Isrc: "DEA123400001"},
+		{Number: 2, Offset: 900, Sectors: 11475, Data: true},
+	}}
+	var b strings.Builder
+	if err := s.FormatTable(&b); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "TRACK  START     LENGTH    OFFSET  SECTORS  ISRC\n"+
+		"1      00:02:00  00:10:00  150     750      DEA123400001\n"+
+		"2      00:12:00  02:33:00  900     11475    (data)\n", b.String())
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+	"time"
+)
+
+// Return the helper functions available in templates parsed with
+// discid.ParseTemplate.
+//
+//	msf      format sectors as MM:SS:FF, see discid.FormatMSF
+//	duration convert sectors into a time.Duration
+//	seconds  convert sectors into whole seconds
+//	pad      format a number with leading zeros, e.g. {{pad 2 .Number}}
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"msf":      FormatMSF,
+		"duration": SectorsToDuration,
+		"seconds": func(sectors int) int {
+			return int(SectorsToDuration(sectors) / time.Second)
+		},
+		"pad": func(width int, n int) string {
+			return fmt.Sprintf("%0*d", width, n)
+		},
+	}
+}
+
+// Parse a text/template for rendering disc data with Snapshot.Render.
+//
+// Besides the functions of text/template the functions returned by
+// discid.TemplateFuncs are available.
+func ParseTemplate(text string) (*template.Template, error) {
+	return template.New("discid").Funcs(TemplateFuncs()).Parse(text)
+}
+
+// Render the snapshot with the given template, e.g. for custom reports,
+// file names or NFO files.
+//
+// The snapshot is the data of the template, hence all fields and methods of
+// Snapshot and Track can be used:
+//
+//	{{.Id}}
+//	{{range .Tracks}}{{pad 2 .Number}} {{msf .Sectors}}
+//	{{end}}
+func (s Snapshot) Render(w io.Writer, tmpl *template.Template) error {
+	return tmpl.Execute(w, s)
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestRender(t *testing.T) {
+	disc, err := discid.Parse("1 2 39738 150 18901")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	tmpl, err := discid.ParseTemplate(`{{.Id}} {{msf .Sectors}}
+{{range .Tracks}}{{pad 2 .Number}} {{.OffsetMSF}} {{seconds .Sectors}}s {{duration .Sectors}}
+{{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := disc.Snapshot().Render(&b, tmpl); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, disc.Id()+" 08:49:63\n"+
+		"01 00:02:00 250s 4m10.013333333s\n"+
+		"02 04:12:01 277s 4m37.826666666s\n", b.String())
+}
+
+func TestParseTemplateError(t *testing.T) {
+	_, err := discid.ParseTemplate("{{unknown .Id}}")
+	assert.Error(t, err)
+}
@@ -0,0 +1,20 @@
+REM GENRE Classical
+REM DATE 1995
+CATALOG 4006381333931
+PERFORMER "Some Orchestra"
+TITLE "Symphony No. 1"
+FILE "Symphony No. 1.wav" WAVE
+  TRACK 01 AUDIO
+    TITLE "I. Allegro"
+    ISRC DEA123400001
+    INDEX 01 00:00:00
+    INDEX 02 03:10:20
+    INDEX 03 05:00:00
+  TRACK 02 AUDIO
+    TITLE "II. Adagio"
+    ISRC DEA123400002
+    INDEX 00 08:30:00
+    INDEX 01 08:32:00
+  TRACK 03 AUDIO
+    TITLE "III. Finale"
+    INDEX 01 15:00:50
@@ -0,0 +1,169 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Returned by discid.ReadWithOptions if a phase of the read did not finish
+// within its timeout. The returned error wraps ErrTimeout, use errors.Is to
+// check for it.
+var ErrTimeout = errors.New("read timed out")
+
+// Read the disc in separate phases for TOC, MCN and ISRCs if any of the
+// phase timeouts, a progress callback or an event callback is set,
+// otherwise read all features at once.
+//
+// Each phase is a separate read with only the feature of the phase. The
+// results are combined and the TOCs of all phases must be identical.
+func readPhases(device string, opts ReadOptions, read func(string, Feature) (Disc, error)) (Disc, error) {
+	if opts.TocTimeout <= 0 && opts.McnTimeout <= 0 && opts.IsrcTimeout <= 0 &&
+		opts.Progress == nil && opts.Events == nil {
+		return read(device, opts.Features)
+	}
+	progress := ReadProgress{Total: estimateSteps(opts.Features, 0)}
+	report := func(steps int) {
+		progress.Completed += steps
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+	}
+	report(0)
+	opts.emit(ReadEvent{Type: EventDeviceOpened, Device: device})
+	disc, err := readWithTimeout("TOC", opts.TocTimeout, func() (Disc, error) {
+		return read(device, FeatureRead|opts.Features&FeatureIndexes)
+	})
+	if err != nil {
+		return Disc{}, err
+	}
+	tracks := disc.LastTrackNum() - disc.FirstTrackNum() + 1
+	progress.Total = estimateSteps(opts.Features, tracks)
+	report(1)
+	opts.emit(ReadEvent{Type: EventTocRead, Device: device, Toc: disc.TocString()})
+	overlay := overlayHandle{handle: disc.handle}
+	phase := func(name string, feature Feature, timeout time.Duration, apply func(Disc)) error {
+		if opts.Features&feature == 0 {
+			return nil
+		}
+		d, err := readWithTimeout(name, timeout, func() (Disc, error) {
+			return read(device, feature)
+		})
+		if err != nil {
+			return err
+		}
+		defer d.Close()
+		if d.TocString() != disc.TocString() {
+			return fmt.Errorf("%w while reading %v", ErrInconsistentToc, name)
+		}
+		apply(d)
+		if feature == FeatureIsrc {
+			report(tracks)
+		} else {
+			report(1)
+		}
+		return nil
+	}
+	err = phase("MCN", FeatureMcn, opts.McnTimeout, func(d Disc) {
+		overlay.mcnStr = d.Mcn()
+		opts.emit(ReadEvent{Type: EventMcnRead, Device: device, Mcn: overlay.mcnStr})
+	})
+	if err == nil {
+		err = phase("ISRCs", FeatureIsrc, opts.IsrcTimeout, func(d Disc) {
+			overlay.isrcs = make(map[int]string)
+			for n := d.FirstTrackNum(); n <= d.LastTrackNum(); n++ {
+				overlay.isrcs[n] = d.Track(n).Isrc
+				opts.emit(ReadEvent{Type: EventIsrcRead, Device: device, Track: n, Isrc: overlay.isrcs[n]})
+			}
+		})
+	}
+	if err != nil {
+		disc.Close()
+		return Disc{}, err
+	}
+	return Disc{overlay}, nil
+}
+
+// Call read and wait at most timeout for it to finish. A timeout of zero
+// waits forever.
+func readWithTimeout(phase string, timeout time.Duration, read func() (Disc, error)) (Disc, error) {
+	if timeout <= 0 {
+		return read()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	disc, err := readContext(ctx, read)
+	if err == context.DeadlineExceeded {
+		return Disc{}, fmt.Errorf("%w: reading %v took longer than %v", ErrTimeout, phase, timeout)
+	}
+	return disc, err
+}
+
+// Read the disc in the given device, returning early if ctx gets cancelled.
+//
+// This works like discid.ReadFeatures. If the context gets cancelled before
+// the read finished, the context's error is returned.
+//
+// SCSI generic devices on Linux are read by this package itself. For these
+// the device is closed on cancellation, which aborts the read after the
+// SCSI command currently in flight.
+//
+// libdiscid opens the device itself and offers no way to interrupt a read,
+// hence reads with libdiscid cannot be aborted. The read runs in a separate
+// goroutine and ReadContext returns immediately on cancellation, while the
+// abandoned read keeps running in the background until the drive responds
+// and the disc gets closed afterwards. Until then the drive might be busy
+// for other reads.
+func ReadContext(ctx context.Context, device string, features Feature) (Disc, error) {
+	if err := ctx.Err(); err != nil {
+		return Disc{}, err
+	}
+	if name := resolveDeviceName(device); isSgDevice(name) {
+		return readSgDevice(ctx, name, features)
+	}
+	return readContext(ctx, func() (Disc, error) {
+		return ReadFeatures(device, features)
+	})
+}
+
+func readContext(ctx context.Context, read func() (Disc, error)) (Disc, error) {
+	if err := ctx.Err(); err != nil {
+		return Disc{}, err
+	}
+	type result struct {
+		disc Disc
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		disc, err := read()
+		results <- result{disc, err}
+	}()
+	select {
+	case r := <-results:
+		return r.disc, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-results; r.err == nil {
+				r.disc.Close()
+			}
+		}()
+		return Disc{}, ctx.Err()
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Return a fake read function returning discs with the given TOC, MCN and
+// ISRCs for the requested features.
+func fakePhaseRead(t *testing.T, toc string, block map[Feature]chan struct{}) func(string, Feature) (Disc, error) {
+	return func(device string, features Feature) (Disc, error) {
+		if ch, ok := block[features]; ok {
+			<-ch
+		}
+		disc, err := Parse(toc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		overlay := overlayHandle{handle: disc.handle}
+		if features&FeatureMcn != 0 {
+			overlay.mcnStr = "4006381333931"
+		}
+		if features&FeatureIsrc != 0 {
+			overlay.isrcs = map[int]string{1: "DEA123400001", 2: "DEA123400002"}
+		}
+		return Disc{overlay}, nil
+	}
+}
+
+func TestReadPhases(t *testing.T) {
+	read := fakePhaseRead(t, "1 2 34567 150 10000", nil)
+	opts := ReadOptions{Features: FeatureAll, McnTimeout: time.Second}
+	disc, err := readPhases("", opts, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, "4006381333931", disc.Mcn())
+	assert.Equal(t, "DEA123400002", disc.Track(2).Isrc)
+
+	opts.Features = FeatureRead
+	disc, err = readPhases("", opts, read)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "", disc.Mcn())
+		assert.Equal(t, "", disc.Track(1).Isrc)
+	}
+}
+
+func TestReadPhasesTimeout(t *testing.T) {
+	block := map[Feature]chan struct{}{FeatureIsrc: make(chan struct{})}
+	defer close(block[FeatureIsrc])
+	read := fakePhaseRead(t, "1 2 34567 150 10000", block)
+	opts := ReadOptions{Features: FeatureAll, IsrcTimeout: 10 * time.Millisecond}
+	_, err := readPhases("", opts, read)
+	assert.True(t, errors.Is(err, ErrTimeout))
+	assert.Contains(t, err.Error(), "reading ISRCs took longer than 10ms")
+}
+
+func TestReadPhasesInconsistentToc(t *testing.T) {
+	tocs := map[Feature]string{FeatureRead: "1 2 34567 150 10000", FeatureMcn: "1 2 34570 150 10000"}
+	read := func(device string, features Feature) (Disc, error) {
+		return Parse(tocs[features])
+	}
+	opts := ReadOptions{Features: FeatureMcn, TocTimeout: time.Second}
+	_, err := readPhases("", opts, read)
+	assert.True(t, errors.Is(err, ErrInconsistentToc))
+}
+
+func TestReadContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	block := make(chan struct{})
+	closed := make(chan struct{})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err := readContext(ctx, func() (Disc, error) {
+		<-block
+		disc, err := Parse("1 1 10000 150")
+		if err != nil {
+			return disc, err
+		}
+		return Disc{closeNotifier{disc.handle, closed}}, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+	close(block)
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Error("abandoned disc was not closed")
+	}
+}
+
+func TestReadContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := readContext(ctx, func() (Disc, error) {
+		t.Fatal("unexpected read")
+		return Disc{}, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+type closeNotifier struct {
+	handle
+	closed chan struct{}
+}
+
+func (h closeNotifier) free() {
+	h.handle.free()
+	close(h.closed)
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The table of contents (TOC) of a disc.
+//
+// Unlike Disc a Toc is a plain value which does not depend on libdiscid.
+// The disc IDs are calculated in Go.
+type Toc struct {
+	// Number of the first track (1-99)
+	First int `json:"first"`
+	// Number of the last track (1-99)
+	Last int `json:"last"`
+	// The leadout offset, which is the length of the disc in sectors
+	Leadout int `json:"leadout"`
+	// The start offsets in sectors of the tracks First to Last
+	TrackOffsets []int `json:"track_offsets"`
+}
+
+// Parse a TOC string in the format returned by Disc.TocString.
+//
+// The values can be separated by any amount of whitespace. Parsing only
+// checks the format, use Toc.Validate to check the values. Errors for
+// invalid values name the offending field, e.g. the leadout.
+func ParseToc(toc string) (Toc, error) {
+	first := 0
+	last := 0
+	var offsets [100]int
+	i := -1
+	var part string
+	for i, part = range strings.Fields(toc) {
+		parsedInt, e := strconv.Atoi(part)
+		if e == nil && parsedInt < 0 {
+			e = errors.New("value must not be negative")
+		}
+		if e != nil {
+			return Toc{}, fmt.Errorf("Invalid %v in TOC string: %w", tocFieldName(i, first), e)
+		}
+		if i == 0 {
+			first = parsedInt
+		} else if i == 1 {
+			last = parsedInt
+		} else {
+			if i > (last+2) || i > 99+2 {
+				return Toc{}, errors.New("TOC string contains too many offsets (max. 100)")
+			}
+			offsets[i-2] = parsedInt
+		}
+	}
+
+	if i < 2 || first < 1 || last < 1 || last > 99 {
+		return Toc{}, fmt.Errorf("Invalid TOC string \"%v\"", toc)
+	}
+
+	offsetCount := i - 2
+	trackCount := last - first + 1
+	if offsetCount < trackCount {
+		return Toc{}, fmt.Errorf("Number of offsets %v does not match track count %v",
+			offsetCount, trackCount)
+	}
+	if trackCount < 0 {
+		trackCount = 0
+	}
+	return Toc{
+		First:        first,
+		Last:         last,
+		Leadout:      offsets[0],
+		TrackOffsets: append([]int{}, offsets[1:trackCount+1]...),
+	}, nil
+}
+
+// Return the name of the field at position i of a TOC string.
+func tocFieldName(i int, first int) string {
+	switch i {
+	case 0:
+		return "first track number"
+	case 1:
+		return "last track number"
+	case 2:
+		return "leadout"
+	default:
+		return fmt.Sprintf("offset of track %v", first+i-3)
+	}
+}
+
+// Return the TOC string in the format of Disc.TocString.
+func (t Toc) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %d %d", t.First, t.Last, t.Leadout)
+	for _, offset := range t.TrackOffsets {
+		fmt.Fprintf(&b, " %d", offset)
+	}
+	return b.String()
+}
+
+// The maximum leadout offset of a disc following the Red Book (80 minutes).
+const MaxRedBookSectors = 80 * 60 * SectorsPerSecond
+
+// Returned by Toc.Validate if the leadout exceeds MaxRedBookSectors. The
+// returned error wraps ErrOverburn, use errors.Is to check for it.
+var ErrOverburn = errors.New("disc exceeds the Red Book maximum length")
+
+// Options for validating a TOC with Toc.ValidateWithOptions.
+type ValidateOptions struct {
+	// Accept discs longer than MaxRedBookSectors.
+	//
+	// CD-Rs can be overburned to hold 90 or even 99 minutes of audio. Such
+	// discs are rejected by default, as the leadout is more likely the
+	// result of a bad read. Even with AllowOverburn the leadout must not
+	// exceed 90 minutes, which is the maximum supported by libdiscid.
+	AllowOverburn bool
+}
+
+// Check the TOC for consistency.
+//
+// The same checks as by discid.Put are performed: the track numbers must be
+// in the range 1-99, there must be an offset for each track, the offsets
+// must be in ascending order and must not exceed the leadout. Additionally
+// the leadout must be greater than the offset of the last track and must
+// not exceed MaxRedBookSectors, see Toc.ValidateWithOptions for accepting
+// overburned discs.
+func (t Toc) Validate() error {
+	return t.ValidateWithOptions(ValidateOptions{})
+}
+
+// Check the TOC for consistency with additional options, see Toc.Validate.
+func (t Toc) ValidateWithOptions(opts ValidateOptions) error {
+	if len(t.TrackOffsets) != t.Last-t.First+1 {
+		return fmt.Errorf("Number of offsets %v does not match track count %v",
+			len(t.TrackOffsets), t.Last-t.First+1)
+	}
+	offsets := t.array()
+	if err := validateToc(t.First, t.Last, &offsets); err != nil {
+		if t.Leadout > maxDiscLength {
+			return fmt.Errorf("%w: leadout at %v exceeds the maximum of %v supported by libdiscid",
+				err, FormatMSF(t.Leadout), FormatMSF(maxDiscLength))
+		}
+		return err
+	}
+	if last := t.TrackOffsets[len(t.TrackOffsets)-1]; t.Leadout <= last {
+		return fmt.Errorf("Invalid leadout: %v must be greater than the offset %v of the last track %v",
+			t.Leadout, last, t.Last)
+	}
+	if !opts.AllowOverburn && t.Leadout > MaxRedBookSectors {
+		return fmt.Errorf("%w: leadout at %v exceeds %v, set AllowOverburn for overburned discs",
+			ErrOverburn, FormatMSF(t.Leadout), FormatMSF(MaxRedBookSectors))
+	}
+	return nil
+}
+
+// Return the offsets in the format expected by discid.Put, with the leadout
+// as the first element followed by the track offsets.
+func (t Toc) Offsets() []int {
+	return append([]int{t.Leadout}, t.TrackOffsets...)
+}
+
+// Calculate the MusicBrainz disc ID.
+//
+// The TOC is not validated, use Toc.Validate first for untrusted data.
+func (t Toc) DiscId() string {
+	offsets := t.array()
+	return calculateId(t.First, t.Last, &offsets)
+}
+
+// Calculate the FreeDB disc ID.
+//
+// The TOC is not validated, use Toc.Validate first for untrusted data.
+func (t Toc) FreedbId() string {
+	offsets := t.array()
+	return calculateFreedbId(t.First, t.Last, &offsets)
+}
+
+// Return a Disc for the TOC, see discid.Put.
+func (t Toc) Disc() (Disc, error) {
+	return Put(t.First, t.Offsets())
+}
+
+// Return the offsets in the layout used by libdiscid, offsets[0] is the
+// leadout and offsets[n] the offset of track n.
+func (t Toc) array() [100]int {
+	var offsets [100]int
+	offsets[0] = t.Leadout
+	for i, offset := range t.TrackOffsets {
+		if n := t.First + i; n > 0 && n < len(offsets) {
+			offsets[n] = offset
+		}
+	}
+	return offsets
+}
+
+// Return the TOC of the disc.
+func (d Disc) Toc() Toc {
+	toc := Toc{
+		First:        d.FirstTrackNum(),
+		Last:         d.LastTrackNum(),
+		Leadout:      d.Sectors(),
+		TrackOffsets: []int{},
+	}
+	for n := toc.First; n <= toc.Last; n++ {
+		toc.TrackOffsets = append(toc.TrackOffsets, d.handle.trackOffset(n))
+	}
+	return toc
+}
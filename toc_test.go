@@ -0,0 +1,94 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+const tocString = "1 10 206535 150 18901 39738 59557 79152 100126 124833 147278 166336 182560"
+
+func TestParseToc(t *testing.T) {
+	assert := assert.New(t)
+	toc, err := discid.ParseToc(tocString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(1, toc.First)
+	assert.Equal(10, toc.Last)
+	assert.Equal(206535, toc.Leadout)
+	assert.Equal([]int{150, 18901, 39738, 59557, 79152, 100126, 124833, 147278, 166336, 182560},
+		toc.TrackOffsets)
+	assert.Equal(tocString, toc.String())
+	assert.NoError(toc.Validate())
+	assert.Equal("Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-", toc.DiscId())
+	assert.Equal("830abf0a", toc.FreedbId())
+	assert.Equal(206535, toc.Offsets()[0])
+	assert.Len(toc.Offsets(), 11)
+}
+
+func TestParseTocInvalid(t *testing.T) {
+	_, err := discid.ParseToc("1 10 206535 150")
+	assert.EqualError(t, err, "Number of offsets 1 does not match track count 10")
+	_, err = discid.ParseToc("1 x")
+	assert.Error(t, err)
+}
+
+func TestTocValidate(t *testing.T) {
+	assert := assert.New(t)
+	toc := discid.Toc{First: 1, Last: 2, Leadout: 1000, TrackOffsets: []int{150}}
+	assert.EqualError(toc.Validate(), "Number of offsets 1 does not match track count 2")
+	toc.TrackOffsets = []int{500, 150}
+	assert.EqualError(toc.Validate(), "Invalid order")
+	toc.TrackOffsets = []int{150, 2000}
+	assert.EqualError(toc.Validate(), "Invalid offset")
+	toc = discid.Toc{First: 0, Last: 1, Leadout: 1000, TrackOffsets: []int{150, 500}}
+	assert.EqualError(toc.Validate(), "Illegal track limits")
+}
+
+func TestTocValidateOverburn(t *testing.T) {
+	assert := assert.New(t)
+	toc := discid.Toc{First: 1, Last: 1, Leadout: 85 * 60 * 75, TrackOffsets: []int{150}}
+	err := toc.Validate()
+	assert.True(errors.Is(err, discid.ErrOverburn))
+	assert.EqualError(err, "disc exceeds the Red Book maximum length: "+
+		"leadout at 85:00:00 exceeds 80:00:00, set AllowOverburn for overburned discs")
+	assert.NoError(toc.ValidateWithOptions(discid.ValidateOptions{AllowOverburn: true}))
+	toc.Leadout = 99 * 60 * 75
+	assert.EqualError(toc.ValidateWithOptions(discid.ValidateOptions{AllowOverburn: true}),
+		"Disc too long: leadout at 99:00:00 exceeds the maximum of 90:00:00 supported by libdiscid")
+}
+
+func TestDiscToc(t *testing.T) {
+	disc, err := discid.Parse(tocString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	toc := disc.Toc()
+	assert.Equal(t, tocString, toc.String())
+	assert.Equal(t, disc.Id(), toc.DiscId())
+	d, err := toc.Disc()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	assert.Equal(t, disc.Id(), d.Id())
+}
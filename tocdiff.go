@@ -0,0 +1,132 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+// Overall result of comparing two TOCs
+type TocVerdict int
+
+const (
+	// Both TOCs are identical
+	TocIdentical TocVerdict = iota
+	// All track offsets and the leadout are moved by the same number of
+	// sectors, e.g. because of a different drive offset
+	TocShifted
+	// The track offsets are identical, only the leadout differs
+	TocLeadoutDiffers
+	// Both discs have the same tracks, but the offsets differ
+	TocTracksDiffer
+	// The discs have a different number of tracks
+	TocTrackCountDiffers
+)
+
+func (v TocVerdict) String() string {
+	switch v {
+	case TocIdentical:
+		return "identical"
+	case TocShifted:
+		return "shifted"
+	case TocLeadoutDiffers:
+		return "leadout differs"
+	case TocTracksDiffer:
+		return "tracks differ"
+	case TocTrackCountDiffers:
+		return "track count differs"
+	default:
+		return "unknown"
+	}
+}
+
+// Differences of a single track present on both compared discs
+type TrackDiff struct {
+	// Track number
+	Number int
+	// Start offsets in sectors on both discs
+	OffsetA, OffsetB int
+	// Track lengths in sectors on both discs
+	SectorsA, SectorsB int
+}
+
+// Return the difference in sectors of the start offsets (B - A).
+func (t TrackDiff) OffsetDelta() int {
+	return t.OffsetB - t.OffsetA
+}
+
+// Return the difference in sectors of the track lengths (B - A).
+func (t TrackDiff) SectorsDelta() int {
+	return t.SectorsB - t.SectorsA
+}
+
+// Check whether the offset or the length of the track differ.
+func (t TrackDiff) Differs() bool {
+	return t.OffsetA != t.OffsetB || t.SectorsA != t.SectorsB
+}
+
+// Result of comparing the TOCs of two discs
+type TocDiff struct {
+	Verdict TocVerdict
+	// Difference in sectors of the leadouts (B - A)
+	LeadoutDelta int
+	// For TocShifted the number of sectors all offsets are moved by
+	Shift int
+	// The tracks present on both discs
+	Tracks []TrackDiff
+}
+
+// Compare the TOCs of two discs and return the differences.
+//
+// This can be used to find out why two apparently identical discs, e.g.
+// different pressings of a release, result in different disc IDs.
+func DiffTocs(a Disc, b Disc) TocDiff {
+	diff := TocDiff{LeadoutDelta: b.Sectors() - a.Sectors()}
+	first := a.FirstTrackNum()
+	if b.FirstTrackNum() > first {
+		first = b.FirstTrackNum()
+	}
+	last := a.LastTrackNum()
+	if b.LastTrackNum() < last {
+		last = b.LastTrackNum()
+	}
+	offsetsDiffer := false
+	for n := first; n <= last; n++ {
+		track := TrackDiff{
+			Number:   n,
+			OffsetA:  a.handle.trackOffset(n),
+			OffsetB:  b.handle.trackOffset(n),
+			SectorsA: a.handle.trackLength(n),
+			SectorsB: b.handle.trackLength(n),
+		}
+		if track.OffsetA != track.OffsetB {
+			offsetsDiffer = true
+		}
+		diff.Tracks = append(diff.Tracks, track)
+	}
+
+	shift, shifted := OffsetDelta(a, b)
+	switch {
+	case a.FirstTrackNum() != b.FirstTrackNum() || a.LastTrackNum() != b.LastTrackNum():
+		diff.Verdict = TocTrackCountDiffers
+	case shifted && shift == 0:
+		diff.Verdict = TocIdentical
+	case shifted:
+		diff.Verdict = TocShifted
+		diff.Shift = shift
+	case !offsetsDiffer:
+		diff.Verdict = TocLeadoutDiffers
+	default:
+		diff.Verdict = TocTracksDiffer
+	}
+	return diff
+}
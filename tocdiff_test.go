@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func diffTocStrings(t *testing.T, a string, b string) discid.TocDiff {
+	discA, err := discid.Parse(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer discA.Close()
+	discB, err := discid.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer discB.Close()
+	return discid.DiffTocs(discA, discB)
+}
+
+func TestDiffTocsIdentical(t *testing.T) {
+	diff := diffTocStrings(t, "1 3 300000 150 100000 200000", "1 3 300000 150 100000 200000")
+	assert.Equal(t, discid.TocIdentical, diff.Verdict)
+	assert.Len(t, diff.Tracks, 3)
+	for _, track := range diff.Tracks {
+		assert.False(t, track.Differs())
+	}
+}
+
+func TestDiffTocsShifted(t *testing.T) {
+	diff := diffTocStrings(t, "1 3 300000 150 100000 200000", "1 3 300010 160 100010 200010")
+	assert.Equal(t, discid.TocShifted, diff.Verdict)
+	assert.Equal(t, 10, diff.Shift)
+	assert.Equal(t, 10, diff.LeadoutDelta)
+	assert.Equal(t, 10, diff.Tracks[0].OffsetDelta())
+	assert.Equal(t, 0, diff.Tracks[0].SectorsDelta())
+}
+
+func TestDiffTocsLeadoutDiffers(t *testing.T) {
+	diff := diffTocStrings(t, "1 3 300000 150 100000 200000", "1 3 299000 150 100000 200000")
+	assert.Equal(t, discid.TocLeadoutDiffers, diff.Verdict)
+	assert.Equal(t, -1000, diff.LeadoutDelta)
+	assert.False(t, diff.Tracks[1].Differs())
+	assert.True(t, diff.Tracks[2].Differs())
+	assert.Equal(t, -1000, diff.Tracks[2].SectorsDelta())
+}
+
+func TestDiffTocsTracksDiffer(t *testing.T) {
+	diff := diffTocStrings(t, "1 3 300000 150 100000 200000", "1 3 300000 150 100100 200000")
+	assert.Equal(t, discid.TocTracksDiffer, diff.Verdict)
+	assert.Equal(t, 100, diff.Tracks[0].SectorsDelta())
+	assert.Equal(t, 100, diff.Tracks[1].OffsetDelta())
+	assert.Equal(t, -100, diff.Tracks[1].SectorsDelta())
+	assert.False(t, diff.Tracks[2].Differs())
+}
+
+func TestDiffTocsTrackCountDiffers(t *testing.T) {
+	diff := diffTocStrings(t, "1 3 300000 150 100000 200000", "1 2 200000 150 100000")
+	assert.Equal(t, discid.TocTrackCountDiffers, diff.Verdict)
+	assert.Len(t, diff.Tracks, 2)
+}
+
+func TestTocVerdictString(t *testing.T) {
+	assert.Equal(t, "shifted", discid.TocShifted.String())
+	assert.Equal(t, "unknown", discid.TocVerdict(99).String())
+}
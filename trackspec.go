@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Describes a single track for discid.FromTracks.
+type TrackSpec struct {
+	// Start offset in sectors.
+	//
+	// If zero the track starts directly after the previous track. The first
+	// track then starts after the 2 second pregap. A data track following
+	// audio tracks starts after the gap between the audio session and the
+	// data session of a multi-session disc (11400 sectors).
+	Offset int
+	// Track length in sectors.
+	//
+	// Required for the last track, as it defines the leadout, and for tracks
+	// followed by a track without an Offset.
+	Length int
+	// True if this is a data track
+	Data bool
+}
+
+// Create a Disc from a list of tracks, starting with track number first.
+//
+// Unlike discid.Put, which expects the leadout as the first element of the
+// offsets, the leadout is calculated from the offset and length of the last
+// track. Tracks can be given either by offset or by length. If both are
+// given for consecutive tracks they must match.
+//
+// The data flags of the tracks are available with Track.Data.
+func FromTracks(first int, tracks []TrackSpec) (Disc, error) {
+	if len(tracks) == 0 {
+		return Disc{}, errors.New("no tracks given")
+	}
+	offsets := []int{0}
+	data := make(map[int]bool)
+	end := pregapSectors
+	for i, spec := range tracks {
+		n := first + i
+		if spec.Offset < 0 || spec.Length < 0 {
+			return Disc{}, fmt.Errorf("track %v: offset and length must not be negative", n)
+		}
+		offset := spec.Offset
+		if i > 0 {
+			prev := tracks[i-1]
+			if prev.Length == 0 && offset == 0 {
+				return Disc{}, fmt.Errorf("track %v needs an offset or track %v a length", n, n-1)
+			}
+			if offset == 0 {
+				offset = end
+				if spec.Data && !prev.Data {
+					offset += dataSessionGap
+				}
+			} else if prev.Length != 0 && offset != end && !(spec.Data && !prev.Data) {
+				return Disc{}, fmt.Errorf("track %v starts at %v, but track %v ends at %v",
+					n, offset, n-1, end)
+			}
+		} else if offset == 0 {
+			offset = pregapSectors
+		}
+		offsets = append(offsets, offset)
+		data[n] = spec.Data
+		end = offset + spec.Length
+	}
+	if tracks[len(tracks)-1].Length == 0 {
+		return Disc{}, errors.New("the last track needs a length to calculate the leadout")
+	}
+	offsets[0] = end
+	disc, err := Put(first, offsets)
+	if err != nil {
+		return disc, err
+	}
+	return Disc{overlayHandle{handle: disc.handle, data: data}}, nil
+}
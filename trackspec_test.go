@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestFromTracks(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.FromTracks(1, []discid.TrackSpec{
+		{Length: 18751},
+		{Offset: 18901, Length: 20837},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal("1 2 39738 150 18901", disc.TocString())
+	assert.False(disc.Track(2).Data)
+}
+
+func TestFromTracksEnhancedCd(t *testing.T) {
+	assert := assert.New(t)
+	disc, err := discid.FromTracks(1, []discid.TrackSpec{
+		{Length: 18751},
+		{Length: 20837},
+		{Length: 30000, Data: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal("1 3 81138 150 18901 51138", disc.TocString())
+	assert.True(disc.Track(3).Data)
+	trimmed, err := disc.TrimLastTrack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer trimmed.Close()
+	assert.Equal("1 2 39738 150 18901", trimmed.TocString())
+}
+
+func TestFromTracksInvalid(t *testing.T) {
+	for _, tracks := range [][]discid.TrackSpec{
+		{},
+		{{Length: 1000}, {Offset: 1000, Length: 1000}},
+		{{Offset: 150}, {Length: 1000}},
+		{{Length: 1000}, {}},
+		{{Length: -1}},
+	} {
+		_, err := discid.FromTracks(1, tracks)
+		assert.Error(t, err, tracks)
+	}
+}
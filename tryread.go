@@ -0,0 +1,49 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "fmt"
+
+// Read the disc only if the drive is able to serve the read right now.
+//
+// TryRead checks the drive status first and returns immediately with an
+// error wrapping discid.ErrNoDisc if the drive has no disc or the tray is
+// open, or wrapping discid.ErrNotReady if the drive is still spinning up.
+// Otherwise the disc is read like with discid.ReadFeatures. This is useful
+// for UI event loops polling the drive.
+//
+// The drive status is currently only available on Linux. On other
+// platforms TryRead always reads the disc and might block.
+func TryRead(device string, features Feature) (Disc, error) {
+	device = resolveDeviceName(device)
+	if device == "" {
+		device = defaultDevice()
+	}
+	return tryRead(device, readDriveStatus(device), func() (Disc, error) {
+		return ReadFeatures(device, features)
+	})
+}
+
+func tryRead(device string, status driveStatus, read func() (Disc, error)) (Disc, error) {
+	switch status {
+	case statusNoDisc:
+		return Disc{}, fmt.Errorf("%w: %v", ErrNoDisc, device)
+	case statusNotReady:
+		return Disc{}, fmt.Errorf("%w: %v", ErrNotReady, device)
+	default:
+		return read()
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryRead(t *testing.T) {
+	reads := 0
+	read := func() (Disc, error) {
+		reads++
+		return Parse("1 1 10000 150")
+	}
+	_, err := tryRead("/dev/sr0", statusNoDisc, read)
+	assert.True(t, errors.Is(err, ErrNoDisc))
+	assert.EqualError(t, err, "no disc in drive: /dev/sr0")
+	_, err = tryRead("/dev/sr0", statusNotReady, read)
+	assert.True(t, errors.Is(err, ErrNotReady))
+	assert.Equal(t, 0, reads)
+
+	for _, status := range []driveStatus{statusDiscOk, statusUnknown} {
+		disc, err := tryRead("/dev/sr0", status, read)
+		if assert.NoError(t, err) {
+			disc.Close()
+		}
+	}
+	assert.Equal(t, 2, reads)
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"fmt"
+	"time"
+)
+
+// Constants describing the audio data of a CD (Red Book).
+const (
+	// Number of sectors (also called frames) per second of audio
+	SectorsPerSecond = 75
+	// Sample rate of the audio in Hz
+	SampleRate = 44100
+	// Number of stereo samples stored in one sector
+	SamplesPerSector = SampleRate / SectorsPerSecond
+	// Size of one stereo sample in bytes (2 channels with 16 bit each)
+	BytesPerSample = 4
+	// Size of the raw audio data of one sector in bytes
+	BytesPerSector = SamplesPerSector * BytesPerSample
+)
+
+// Convert a number of sectors into the number of stereo samples.
+func SectorsToSamples(sectors int) int {
+	return sectors * SamplesPerSector
+}
+
+// Convert a number of stereo samples into sectors.
+//
+// Incomplete sectors are not counted.
+func SamplesToSectors(samples int) int {
+	return samples / SamplesPerSector
+}
+
+// Convert a number of sectors into the size of the raw audio data in bytes.
+func SectorsToBytes(sectors int) int {
+	return sectors * BytesPerSector
+}
+
+// Convert a raw audio data size in bytes into sectors.
+//
+// Incomplete sectors are not counted.
+func BytesToSectors(bytes int) int {
+	return bytes / BytesPerSector
+}
+
+// Convert a number of stereo samples into the size of the raw audio data in bytes.
+func SamplesToBytes(samples int) int {
+	return samples * BytesPerSample
+}
+
+// Convert a raw audio data size in bytes into stereo samples.
+//
+// Incomplete samples are not counted.
+func BytesToSamples(bytes int) int {
+	return bytes / BytesPerSample
+}
+
+// Convert a number of sectors into the playing time.
+func SectorsToDuration(sectors int) time.Duration {
+	return time.Duration(sectors) * time.Second / SectorsPerSecond
+}
+
+// Convert a playing time into sectors.
+//
+// Incomplete sectors are not counted.
+func DurationToSectors(d time.Duration) int {
+	return int(d * SectorsPerSecond / time.Second)
+}
+
+// Format a number of sectors as MM:SS:FF (minutes, seconds and frames).
+//
+// A frame is a single sector, there are 75 frames per second.
+func FormatMSF(sectors int) string {
+	frames := sectors % SectorsPerSecond
+	seconds := sectors / SectorsPerSecond
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/60, seconds%60, frames)
+}
+
+// Return the start offset of the track formatted as MM:SS:FF.
+//
+// Like Track.Offset this is the absolute position on the disc including the
+// two second pregap, e.g. "00:02:00" for a first track starting at sector 150.
+func (t Track) OffsetMSF() string {
+	return FormatMSF(t.Offset)
+}
+
+// Return the length of the track formatted as MM:SS:FF.
+func (t Track) LengthMSF() string {
+	return FormatMSF(t.Sectors)
+}
+
+// Return the offset directly after the track.
+//
+// This is the first sector not belonging to the track anymore, which is the
+// offset of the next track or the leadout.
+func (t Track) EndOffset() int {
+	return t.Offset + t.Sectors
+}
+
+// Return the offset of the last sector of the track.
+func (t Track) EndSector() int {
+	return t.EndOffset() - 1
+}
+
+// Return the start time of the track from the start of the disc.
+//
+// Unlike Track.Offset this does not include the two second pregap, hence a
+// first track starting at sector 150 starts at 0. Use this for chapter
+// marks or cue points of an image of the whole disc.
+func (t Track) StartTime() time.Duration {
+	return SectorsToDuration(t.Offset - pregapSectors)
+}
+
+// Return the end time of the track from the start of the disc, see
+// Track.StartTime.
+func (t Track) EndTime() time.Duration {
+	return SectorsToDuration(t.EndOffset() - pregapSectors)
+}
+
+// Return the start of the track in stereo samples from the start of the
+// disc.
+//
+// Like Track.StartTime this does not include the two second pregap, which
+// matches the track offsets of FLAC cuesheets for a whole disc image.
+func (t Track) StartSample() int {
+	return SectorsToSamples(t.Offset - pregapSectors)
+}
+
+// Return the end of the track in stereo samples from the start of the disc,
+// see Track.StartSample. This is the first sample not belonging to the track
+// anymore.
+func (t Track) EndSample() int {
+	return SectorsToSamples(t.EndOffset() - pregapSectors)
+}
+
+// Return the length of the track in stereo samples.
+func (t Track) LengthSamples() int {
+	return SectorsToSamples(t.Sectors)
+}
+
+// The gap in sectors between the audio session and the data session of a
+// multi-session disc (Enhanced CD).
+const dataSessionGap = 11400
+
+// Return the total playing time of the audio tracks.
+//
+// Data tracks are not counted. If the last track is a data track following
+// audio tracks the disc is considered a multi-session disc and the gap
+// between the sessions is not counted as well.
+func (d Disc) AudioDuration() time.Duration {
+	tracks := []Track{}
+	for n := d.FirstTrackNum(); n <= d.LastTrackNum(); n++ {
+		tracks = append(tracks, d.Track(n))
+	}
+	return SectorsToDuration(audioSectors(tracks))
+}
+
+func audioSectors(tracks []Track) int {
+	sectors := 0
+	for _, track := range audioTracks(tracks) {
+		sectors += track.Sectors
+	}
+	return sectors
+}
+
+// Return only the audio tracks. The gap to a following data session is
+// removed from the length of the last audio track.
+func audioTracks(tracks []Track) []Track {
+	audio := []Track{}
+	for i, track := range tracks {
+		if track.Data {
+			continue
+		}
+		if i == len(tracks)-2 && tracks[i+1].Data && track.Sectors > dataSessionGap {
+			track.Sectors -= dataSessionGap
+		}
+		audio = append(audio, track)
+	}
+	return audio
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestUnitConstants(t *testing.T) {
+	assert.Equal(t, 588, discid.SamplesPerSector)
+	assert.Equal(t, 2352, discid.BytesPerSector)
+}
+
+func TestSectorConversions(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(441000, discid.SectorsToSamples(750))
+	assert.Equal(750, discid.SamplesToSectors(441000))
+	assert.Equal(750, discid.SamplesToSectors(441587))
+	assert.Equal(1764000, discid.SectorsToBytes(750))
+	assert.Equal(750, discid.BytesToSectors(1764000))
+	assert.Equal(749, discid.BytesToSectors(1763999))
+	assert.Equal(1764000, discid.SamplesToBytes(441000))
+	assert.Equal(441000, discid.BytesToSamples(1764003))
+}
+
+func TestDurationConversions(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(10*time.Second, discid.SectorsToDuration(750))
+	assert.Equal(40*time.Millisecond, discid.SectorsToDuration(3))
+	assert.Equal(750, discid.DurationToSectors(10*time.Second))
+	assert.Equal(0, discid.DurationToSectors(13*time.Millisecond))
+	assert.Equal(1, discid.DurationToSectors(14*time.Millisecond))
+}
+
+func TestFormatMSF(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("00:00:00", discid.FormatMSF(0))
+	assert.Equal("00:02:00", discid.FormatMSF(150))
+	assert.Equal("53:52:57", discid.FormatMSF(242457))
+	assert.Equal("100:00:01", discid.FormatMSF(450001))
+}
+
+func TestTrackMSF(t *testing.T) {
+	track := discid.Track{Number: 1, Offset: 150, Sectors: 44792}
+	assert.Equal(t, "00:02:00", track.OffsetMSF())
+	assert.Equal(t, "09:57:17", track.LengthMSF())
+}
+
+func TestTrackEndOffset(t *testing.T) {
+	disc, err := discid.Parse("1 2 34567 150 10000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	track := disc.Track(1)
+	assert.Equal(t, 10000, track.EndOffset())
+	assert.Equal(t, 9999, track.EndSector())
+	assert.Equal(t, disc.Track(2).Offset, track.EndOffset())
+	assert.Equal(t, disc.Leadout().Offset, disc.Track(2).EndOffset())
+}
+
+func TestTrackStartEndTime(t *testing.T) {
+	track := discid.Track{Number: 1, Offset: 150, Sectors: 750}
+	assert.Equal(t, time.Duration(0), track.StartTime())
+	assert.Equal(t, 10*time.Second, track.EndTime())
+	track = discid.Track{Number: 2, Offset: 900, Sectors: 75}
+	assert.Equal(t, 10*time.Second, track.StartTime())
+	assert.Equal(t, 11*time.Second, track.EndTime())
+}
+
+func TestTrackSamples(t *testing.T) {
+	track := discid.Track{Number: 2, Offset: 900, Sectors: 75}
+	assert.Equal(t, 441000, track.StartSample())
+	assert.Equal(t, 485100, track.EndSample())
+	assert.Equal(t, 44100, track.LengthSamples())
+}
+
+func TestAudioDuration(t *testing.T) {
+	disc, err := discid.Parse("1 3 300000 150 100000 200000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, discid.SectorsToDuration(299850), disc.AudioDuration())
+}
+
+func TestAudioDurationDataTracks(t *testing.T) {
+	cue := `FILE "disc.bin" BINARY
+  TRACK 01 AUDIO
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    INDEX 01 10:00:00
+  TRACK 03 MODE1/2352
+    INDEX 01 22:32:00
+`
+	disc, err := discid.ParseCueSheet(strings.NewReader(cue), 200000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.True(t, disc.Track(3).Data)
+	assert.False(t, disc.Track(2).Data)
+	assert.Equal(t, 20*time.Minute, disc.AudioDuration())
+}
+
+func TestAudioDurationMixedMode(t *testing.T) {
+	cue := `FILE "disc.bin" BINARY
+  TRACK 01 MODE2/2352
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    INDEX 01 10:00:00
+`
+	disc, err := discid.ParseCueSheet(strings.NewReader(cue), 90000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.Equal(t, 10*time.Minute, disc.AudioDuration())
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "fmt"
+
+// Return a handle calculating the disc data in Go for the TOC of d.
+func (d Disc) goTocHandle() (*goHandle, error) {
+	toc := d.Toc()
+	offsets := toc.array()
+	return newGoHandle(toc.First, toc.Last, &offsets)
+}
+
+// Check the disc ID returned by the backend against the value calculated
+// in Go.
+//
+// An error is returned if both values differ.
+func (d Disc) VerifyId() error {
+	h, err := d.goTocHandle()
+	if err != nil {
+		return err
+	}
+	expected := h.id()
+	if actual := d.Id(); actual != expected {
+		return fmt.Errorf("disc ID mismatch: backend returned %q, expected %q", actual, expected)
+	}
+	return nil
+}
+
+// Check the disc ID and the FreeDB ID returned by the backend against the
+// values calculated in Go.
+//
+// This is useful for validating libdiscid or another backend on a new
+// platform. The first mismatch found is returned as an error.
+func (d Disc) Verify() error {
+	if err := d.VerifyId(); err != nil {
+		return err
+	}
+	return d.VerifyFreedbId()
+}
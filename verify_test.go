@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	disc, err := Parse("1 10 206535 150 18901 39738 59557 79152 100126 124833 147278 166336 182560")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	assert.NoError(t, disc.VerifyId())
+	assert.NoError(t, disc.Verify())
+}
+
+func TestVerifyIdMismatch(t *testing.T) {
+	disc, err := Parse("1 10 206535 150 18901 39738 59557 79152 100126 124833 147278 166336 182560")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer disc.Close()
+	disc = Disc{idOverride{disc.handle, "lSOVc5h6IXSuzcamJS1Gp4_tRuA-"}}
+	expected := `disc ID mismatch: backend returned "lSOVc5h6IXSuzcamJS1Gp4_tRuA-", expected "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"`
+	assert.EqualError(t, disc.VerifyId(), expected)
+	assert.EqualError(t, disc.Verify(), expected)
+}
+
+type idOverride struct {
+	handle
+	value string
+}
+
+func (h idOverride) id() string {
+	return h.value
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import "strings"
+
+// Replace aliases registered with discid.RegisterDeviceAlias and Windows
+// volume GUID paths by the device.
+func resolveDeviceName(name string) string {
+	return resolveVolumeGuidPath(resolveDeviceAlias(name))
+}
+
+// Check whether name is a Windows volume GUID path like
+// `\\?\Volume{26a21bda-a627-11d7-9931-806e6f6e6963}\`.
+func isVolumeGuidPath(name string) bool {
+	name = strings.TrimSuffix(name, `\`)
+	return strings.HasPrefix(strings.ToLower(name), `\\?\volume{`) && strings.HasSuffix(name, "}")
+}
+
+// Return the drive letter, e.g. "D:", of the first mount point which is the
+// root of a drive, or an empty string if there is none.
+func driveFromMountPoints(paths []string) string {
+	for _, path := range paths {
+		drive := strings.TrimSuffix(path, `\`)
+		if len(drive) == 2 && isAsciiLetter(drive[0]) && drive[1] == ':' {
+			return strings.ToUpper(drive)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsVolumeGuidPath(t *testing.T) {
+	assert.True(t, isVolumeGuidPath(`\\?\Volume{26a21bda-a627-11d7-9931-806e6f6e6963}\`))
+	assert.True(t, isVolumeGuidPath(`\\?\volume{26a21bda-a627-11d7-9931-806e6f6e6963}`))
+	assert.False(t, isVolumeGuidPath(`\\.\D:`))
+	assert.False(t, isVolumeGuidPath("D:"))
+}
+
+func TestDriveFromMountPoints(t *testing.T) {
+	assert.Equal(t, "E:", driveFromMountPoints([]string{`C:\mnt\cd\`, `e:\`}))
+	assert.Equal(t, "", driveFromMountPoints([]string{`C:\mnt\cd\`}))
+	assert.Equal(t, "", driveFromMountPoints(nil))
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+var procGetVolumePathNamesForVolumeNameW = kernel32.NewProc("GetVolumePathNamesForVolumeNameW")
+
+// Resolve a volume GUID path to the drive letter the volume is mounted at.
+//
+// Drive letters of removable drives change if many devices are attached,
+// while the volume GUID path stays the same. Other names and volumes
+// without drive letter are returned unchanged.
+func resolveVolumeGuidPath(name string) string {
+	if !isVolumeGuidPath(name) {
+		return name
+	}
+	volume, err := syscall.UTF16PtrFromString(strings.TrimSuffix(name, `\`) + `\`)
+	if err != nil {
+		return name
+	}
+	buf := make([]uint16, 1024)
+	var length uint32
+	ok, _, _ := procGetVolumePathNamesForVolumeNameW.Call(uintptr(unsafe.Pointer(volume)),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)), uintptr(unsafe.Pointer(&length)))
+	if ok == 0 {
+		return name
+	}
+	if drive := driveFromMountPoints(splitMultiSz(buf)); drive != "" {
+		return drive
+	}
+	return name
+}
+
+// Split a list of null-terminated strings ending with an empty string.
+func splitMultiSz(buf []uint16) []string {
+	strs := []string{}
+	for start := 0; start < len(buf); {
+		end := start
+		for end < len(buf) && buf[end] != 0 {
+			end++
+		}
+		if end == start {
+			break
+		}
+		strs = append(strs, syscall.UTF16ToString(buf[start:end]))
+		start = end + 1
+	}
+	return strs
+}
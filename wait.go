@@ -0,0 +1,83 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"time"
+)
+
+// Wait until a disc can be read in any of the given drives and read it.
+//
+// All drives are polled concurrently in the given interval, which defaults
+// to DefaultWatchInterval, until a disc could be read from one of them. The
+// disc is read with the given features and returned together with the
+// device it was read from. If devices is empty the drives returned by
+// discid.ListDevices are polled. This is useful for stations with multiple
+// drives, where a disc might be inserted into any of them.
+//
+// If devices is empty and the system has no disc drive ErrNoDrive is
+// returned. If the context gets cancelled before a disc was found the error
+// of the context is returned.
+func WaitForDisc(ctx context.Context, devices []string, features Feature, interval time.Duration) (Disc, string, error) {
+	if len(devices) == 0 {
+		devices = ListDevices()
+		if len(devices) == 0 {
+			return Disc{}, "", ErrNoDrive
+		}
+	}
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type result struct {
+		disc   Disc
+		device string
+	}
+	results := make(chan result)
+	for _, device := range devices {
+		go func(device string) {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				disc, err := ReadFeatures(device, features)
+				if err == nil {
+					select {
+					case results <- result{disc, device}:
+					case <-ctx.Done():
+						// Another drive was faster
+						disc.Close()
+					}
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(device)
+	}
+
+	select {
+	case r := <-results:
+		return r.disc, r.device, nil
+	case <-ctx.Done():
+		return Disc{}, "", ctx.Err()
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestWaitForDiscNoDisc(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	devices := []string{"notadevice", "notadevice2"}
+	_, device, err := discid.WaitForDisc(ctx, devices, discid.FeatureRead, 10*time.Millisecond)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, "", device)
+}
+
+func ExampleWaitForDisc() {
+	disc, device, err := discid.WaitForDisc(context.Background(), nil, discid.FeatureRead, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer disc.Close()
+	fmt.Printf("Disc %v inserted in %v\n", disc.Id(), device)
+}
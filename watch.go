@@ -0,0 +1,110 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid
+
+import (
+	"context"
+	"time"
+)
+
+// The polling interval used by Watcher if none is set.
+const DefaultWatchInterval = 2 * time.Second
+
+// Watches a disc drive and reports inserted and removed discs.
+//
+// The drive is polled by reading the TOC in regular intervals. Once a new
+// disc is detected it is read again with the configured features.
+type Watcher struct {
+	// The device to watch. If empty the default device is used.
+	Device string
+	// The features to read for newly inserted discs.
+	Features Feature
+	// The time between polling the drive. Defaults to DefaultWatchInterval.
+	Interval time.Duration
+	// Called for each newly detected disc.
+	OnInsert func(s Snapshot)
+	// Called after the previously detected disc has been removed.
+	OnRemove func()
+	// If set the disc data gets POSTed to this webhook for each newly
+	// detected disc.
+	Webhook *Webhook
+	// Called for errors occurring while reading a newly detected disc or
+	// while notifying the webhook.
+	OnError func(err error)
+}
+
+// Watch the drive until the context gets cancelled.
+//
+// Always returns a non-nil error, which is the error of the context.
+func (w *Watcher) Run(ctx context.Context) error {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	toc := ""
+	for {
+		toc = w.poll(ctx, toc)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check the drive once and return the TOC string of the current disc.
+func (w *Watcher) poll(ctx context.Context, previous string) string {
+	disc, err := Read(w.Device)
+	if err != nil {
+		if previous != "" && w.OnRemove != nil {
+			w.OnRemove()
+		}
+		return ""
+	}
+	toc := disc.TocString()
+	disc.Close()
+	if toc == previous {
+		return toc
+	}
+	if previous != "" && w.OnRemove != nil {
+		w.OnRemove()
+	}
+
+	disc, err = ReadFeatures(w.Device, w.Features)
+	if err != nil {
+		w.reportError(err)
+		return ""
+	}
+	s := disc.Snapshot()
+	disc.Close()
+	if w.OnInsert != nil {
+		w.OnInsert(s)
+	}
+	if w.Webhook != nil {
+		if err := w.Webhook.Notify(ctx, s); err != nil {
+			w.reportError(err)
+		}
+	}
+	return s.TocString
+}
+
+func (w *Watcher) reportError(err error) {
+	if w.OnError != nil {
+		w.OnError(err)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Philipp Wolfer <ph.wolfer@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discid_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uploadedlobster.com/discid"
+)
+
+func TestWatcherNoDisc(t *testing.T) {
+	inserted := false
+	w := discid.Watcher{
+		Device:   "notadevice",
+		Interval: 10 * time.Millisecond,
+		OnInsert: func(s discid.Snapshot) { inserted = true },
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := w.Run(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.False(t, inserted)
+}
+
+func ExampleWatcher() {
+	w := discid.Watcher{
+		Features: discid.FeatureMcn | discid.FeatureIsrc,
+		OnInsert: func(s discid.Snapshot) {
+			fmt.Printf("Disc inserted: %v\n", s.Id)
+		},
+		Webhook: &discid.Webhook{Url: "http://localhost:8080/disc"},
+	}
+	log.Fatal(w.Run(context.Background()))
+}